@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/tracing"
+)
+
+// Config of gateway is loaded from YAML file, environment variables and flags, see package config.
+type Config struct {
+	Address           string        `yaml:"address" env:"GATEWAY_ADDRESS" flag:"address" usage:"address of http gateway"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"GATEWAY_READ_HEADER_TIMEOUT"`
+	// ShutdownTimeout is time of waiting for running requests on shutdown, then they are closed.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"GATEWAY_SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" usage:"time of waiting for running requests on shutdown"`
+	// HealthCheckTimeout is timeout of health check of grpc server by readiness probe.
+	HealthCheckTimeout time.Duration `yaml:"health_check_timeout" env:"GATEWAY_HEALTH_CHECK_TIMEOUT"`
+	UploadChunkSize    int           `yaml:"upload_chunk_size" env:"GATEWAY_UPLOAD_CHUNK_SIZE"`
+	// MaxUploadSize rejects larger files before they are read, it is the same as max file size of server,
+	// zero is unlimited.
+	MaxUploadSize uint64       `yaml:"max_upload_size" env:"GATEWAY_MAX_UPLOAD_SIZE"`
+	TLS           TLSConfig    `yaml:"tls"`
+	Server        ServerConfig `yaml:"server"`
+	// SignedURLSecret enables download and upload by signed urls, it is the same as secret of server.
+	SignedURLSecret string         `yaml:"signed_url_secret" env:"SIGNED_URL_SECRET" secret:"true"`
+	Tracing         tracing.Config `yaml:"tracing"`
+	Log             logging.Config `yaml:"log"`
+}
+
+// TLSConfig disables HTTPS if certificate is not set.
+type TLSConfig struct {
+	CertFile          string `yaml:"cert_file" env:"GATEWAY_TLS_CERT_FILE" flag:"tls-cert" usage:"PEM file of gateway certificate, HTTPS is disabled if it is empty"`
+	KeyFile           string `yaml:"key_file" env:"GATEWAY_TLS_KEY_FILE" flag:"tls-key" usage:"PEM file of gateway private key"`
+	ClientCAFile      string `yaml:"client_ca_file" env:"GATEWAY_TLS_CLIENT_CA_FILE" flag:"tls-client-ca" usage:"PEM file of CAs of HTTP client certificates"`
+	RequireClientCert bool   `yaml:"require_client_cert" env:"GATEWAY_TLS_REQUIRE_CLIENT_CERT" flag:"tls-require-client-cert" usage:"reject HTTP clients without certificate"`
+}
+
+// ServerConfig is connection to grpc server.
+type ServerConfig struct {
+	Address     string          `yaml:"address" env:"GATEWAY_SERVER_ADDRESS" flag:"server-address" usage:"address of grpc server"`
+	DialTimeout time.Duration   `yaml:"dial_timeout" env:"GATEWAY_DIAL_TIMEOUT" flag:"dial-timeout" usage:"timeout of wait dial connect to server"`
+	TLS         ServerTLSConfig `yaml:"tls"`
+}
+
+// ServerTLSConfig enables TLS of connection to server if it is enabled explicitly or any file is set.
+type ServerTLSConfig struct {
+	Enabled  bool   `yaml:"enabled" env:"SERVER_TLS" flag:"server-tls" usage:"connect to grpc server over TLS, it is enabled by any server-tls-* flag too"`
+	CAFile   string `yaml:"ca_file" env:"SERVER_TLS_CA_FILE" flag:"server-tls-ca" usage:"PEM file of CAs of grpc server certificate, system CAs if it is empty"`
+	CertFile string `yaml:"cert_file" env:"SERVER_TLS_CERT_FILE" flag:"server-tls-cert" usage:"PEM file of gateway client certificate for mutual TLS with grpc server"`
+	KeyFile  string `yaml:"key_file" env:"SERVER_TLS_KEY_FILE" flag:"server-tls-key" usage:"PEM file of gateway client private key"`
+	Name     string `yaml:"name" env:"SERVER_TLS_NAME" flag:"server-tls-name" usage:"name of grpc server in its certificate, host of server address if it is empty"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Address:            "0.0.0.0:8080",
+		ReadHeaderTimeout:  time.Second * 10,
+		ShutdownTimeout:    time.Second * 30,
+		HealthCheckTimeout: time.Second,
+		UploadChunkSize:    1024,
+		Server: ServerConfig{
+			Address:     "localhost:9000",
+			DialTimeout: time.Second * 30,
+		},
+		Tracing: tracing.DefaultConfig(),
+		Log:     logging.DefaultConfig(),
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.Address == "" || c.Server.Address == "" {
+		return errors.New("addresses of gateway and grpc server are required")
+	}
+	if c.Server.DialTimeout <= 0 {
+		return errors.New("dial timeout must be positive")
+	}
+	if c.UploadChunkSize <= 0 {
+		return errors.New("upload chunk size must be positive")
+	}
+	if c.ReadHeaderTimeout < 0 || c.ShutdownTimeout < 0 {
+		return errors.New("read header and shutdown timeouts must not be negative")
+	}
+	if c.HealthCheckTimeout <= 0 {
+		return errors.New("health check timeout must be positive")
+	}
+
+	if err := c.Tracing.Validate(); err != nil {
+		return fmt.Errorf("invalid tracing config: %w", err)
+	}
+	if err := c.Log.Validate(); err != nil {
+		return fmt.Errorf("invalid log config: %w", err)
+	}
+
+	if c.TLS.CertFile == "" && (c.TLS.ClientCAFile != "" || c.TLS.RequireClientCert) {
+		return errors.New("tls certificate of gateway is required for client certificates")
+	}
+	if c.TLS.CertFile != "" && c.TLS.KeyFile == "" {
+		return errors.New("tls key of gateway is required")
+	}
+	if c.TLS.RequireClientCert && c.TLS.ClientCAFile == "" {
+		return errors.New("CAs of client certificates are required to require client certificate")
+	}
+	if c.Server.TLS.CertFile != "" && c.Server.TLS.KeyFile == "" {
+		return errors.New("tls key of gateway client certificate is required")
+	}
+
+	return nil
+}
+
+func (c *ServerTLSConfig) IsEnabled() bool {
+	return c.Enabled || c.CAFile != "" || c.CertFile != ""
+}
@@ -2,39 +2,58 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"strconv"
 
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/signedurl"
 	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
 	runtime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type FilesServiceProxy struct {
 	filesServiceClient files.FilesServiceClient
 	mux                *runtime.ServeMux
+	// signedURLSigner verifies signed urls, it is nil if signed urls are disabled.
+	signedURLSigner *signedurl.Signer
 
 	uploadFileChunkSize int
+	// maxUploadSize is max size of uploaded file, zero is unlimited.
+	maxUploadSize uint64
 }
 
 func NewFilesServiceProxy(
 	filesServiceClient files.FilesServiceClient,
 	mux *runtime.ServeMux,
+	signedURLSigner *signedurl.Signer,
+	uploadFileChunkSize int,
+	maxUploadSize uint64,
 ) *FilesServiceProxy {
 	return &FilesServiceProxy{
 		filesServiceClient:  filesServiceClient,
 		mux:                 mux,
-		uploadFileChunkSize: 1024,
+		signedURLSigner:     signedURLSigner,
+		uploadFileChunkSize: uploadFileChunkSize,
+		maxUploadSize:       maxUploadSize,
 	}
 }
 
 func (p *FilesServiceProxy) RegistrationHTTP(mux *runtime.ServeMux) {
 	mux.HandlePath(http.MethodPost, uploadFilePathPattern, p.UploadFile)
 	mux.HandlePath(http.MethodGet, downloadFilePathPattern, p.DownloadFile)
+	mux.HandlePath(http.MethodHead, downloadFilePathPattern, p.HeadFile)
 }
 
 const uploadFilePathPattern = "/v1/files"
@@ -49,37 +68,116 @@ func (p *FilesServiceProxy) UploadFile(w http.ResponseWriter, req *http.Request,
 	}
 
 	res, err := p.uploadFile(ctx, req)
+	if st, ok := status.FromError(grpcStatusError(err)); ok && isPreconditionFailed(st) {
+		// Gateway maps FAILED_PRECONDITION to 400, but failed If-Match is 412 by HTTP semantics.
+		// Other failed preconditions keep mapping of gateway.
+		writeStatus(w, outboundMarshaler, st, http.StatusPreconditionFailed)
+		return
+	}
+	if st, ok := status.FromError(grpcStatusError(err)); ok && st.Code() == codes.ResourceExhausted && !isRetryable(st) {
+		// Gateway maps RESOURCE_EXHAUSTED to 429, but file over limit is 413 by HTTP semantics.
+		// Rate limited upload is still 429, it has RetryInfo.
+		writeStatus(w, outboundMarshaler, st, http.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
-		runtime.HTTPError(ctx, p.mux, outboundMarshaler, w, req, err)
+		runtime.HTTPError(ctx, p.mux, outboundMarshaler, w, req, grpcStatusError(err))
 		return
 	}
 
 	runtime.ForwardResponseMessage(ctx, p.mux, outboundMarshaler, w, req, res, p.mux.GetForwardResponseOptions()...)
 }
 
-const formFileName = "attachment"
+const (
+	formFileName = "attachment"
+	// formName is optional full name of file with folders, file name of attachment is used if it is empty.
+	formName = "name"
+	// formSHA256 and formCRC32C are optional expected checksums of file, hex encoded SHA-256 and decimal CRC32C.
+	formSHA256 = "sha256"
+	formCRC32C = "crc32c"
+	// maxUploadFormOverhead is size of form fields and boundaries of parts, which is allowed over max upload size.
+	maxUploadFormOverhead = 1 << 20
+)
 
 func (p *FilesServiceProxy) uploadFile(ctx context.Context, req *http.Request) (resp *files.UploadFileResponse, err error) {
+	// Signature is in query, so forged signed URL is rejected before body is read and spilled to temp files.
+	ctx, claims, err := p.verifySignedURL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if claims != nil && claims.Method != req.Method {
+		return nil, status.Errorf(codes.PermissionDenied, "signed url does not allow %s", req.Method)
+	}
+
+	var body *limitedBody
+	if p.maxUploadSize > 0 {
+		limit := int64(p.maxUploadSize) + maxUploadFormOverhead
+		if req.ContentLength > limit {
+			return nil, p.uploadTooLargeError()
+		}
+		body = newLimitedBody(req.Body, limit)
+		req.Body = body
+	}
+
 	if err = req.ParseForm(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid form: %s", err)
 	}
 
 	f, header, err := req.FormFile(formFileName)
+	if body != nil && body.exceeded {
+		return nil, p.uploadTooLargeError()
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid form file %s: %s", formFileName, err)
 	}
 	defer f.Close()
 
-	stream, err := p.filesServiceClient.UploadFile(req.Context())
+	if p.maxUploadSize > 0 && uint64(header.Size) > p.maxUploadSize {
+		return nil, p.uploadTooLargeError()
+	}
+
+	// Multipart reader drops folders from file name of attachment, so full name is passed separately.
+	name := req.FormValue(formName)
+	if name == "" && claims != nil {
+		name = claims.Name
+	}
+	if name == "" {
+		name = header.Filename
+	}
+	if claims != nil && !claims.Allows(req.Method, name) {
+		return nil, status.Errorf(codes.PermissionDenied, "signed url does not allow upload of %s", name)
+	}
+
+	fileInfo := files.UploadFileRequest_Info{
+		Name:        name,
+		ContentType: header.Header.Get("content-type"),
+		// Size is known from form, so server rejects file over quota before content is sent.
+		Size:   uint64(header.Size),
+		Sha256: req.FormValue(formSHA256),
+	}
+	if v := req.FormValue(formCRC32C); v != "" {
+		crc32c, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid form value %s: %s", formCRC32C, err)
+		}
+		fileInfo.Crc32C = wrapperspb.UInt32(uint32(crc32c))
+	}
+	if etags, ok := ifMatchETags(req.Header.Get("if-match")); ok {
+		if len(etags) == 0 {
+			return nil, preconditionFailedError("if-match has only weak etags")
+		}
+		fileInfo.IfMatch = etags
+	}
+
+	// Context is annotated by metadata of request, for example authorization header.
+	stream, err := p.filesServiceClient.UploadFile(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("start upload file grpc stream: %w", err)
 	}
 
 	err = stream.Send(&files.UploadFileRequest{
 		Data: &files.UploadFileRequest_FileInfo{
-			FileInfo: &files.UploadFileRequest_Info{
-				Name: header.Filename,
-			},
+			FileInfo: &fileInfo,
 		},
 	})
 	if err != nil {
@@ -103,12 +201,19 @@ func (p *FilesServiceProxy) uploadFile(ctx context.Context, req *http.Request) (
 				FileContentChunk: chunk[:n],
 			},
 		})
+		// Server closed stream with error, for example failed precondition, error is received by CloseAndRecv.
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
 			return nil, fmt.Errorf("send chunk of file content to stream: %w", err)
 		}
 	}
 
 	resp, err = stream.CloseAndRecv()
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("close stream and wait complete on server: %w", err)
 	}
@@ -116,7 +221,7 @@ func (p *FilesServiceProxy) uploadFile(ctx context.Context, req *http.Request) (
 	return resp, nil
 }
 
-const downloadFilePathPattern = "/v1/files/{name}"
+const downloadFilePathPattern = "/v1/files/{name=**}"
 
 func (p *FilesServiceProxy) DownloadFile(resw http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 	_, outboundMarshaler := runtime.MarshalerForRequest(p.mux, req)
@@ -127,47 +232,362 @@ func (p *FilesServiceProxy) DownloadFile(resw http.ResponseWriter, req *http.Req
 		return
 	}
 
-	if err := p.downloadFile(ctx, resw, req, pathParams); err != nil {
-		runtime.HTTPError(ctx, p.mux, outboundMarshaler, resw, req, err)
+	w := &headerTrackingResponseWriter{ResponseWriter: resw}
+
+	if err := p.downloadFile(ctx, w, req, pathParams); err != nil {
+		if w.wroteHeader {
+			// Status is already sent, so client is notified about error by broken connection.
+			panic(http.ErrAbortHandler)
+		}
+		runtime.HTTPError(ctx, p.mux, outboundMarshaler, resw, req, grpcStatusError(err))
+		return
+	}
+}
+
+// HeadFile answers HEAD request by header of file, content of file is not opened.
+func (p *FilesServiceProxy) HeadFile(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(p.mux, req)
+
+	ctx, err := runtime.AnnotateContext(req.Context(), p.mux, req, "/example.files.v1.FilesService/GetFileHeader", runtime.WithHTTPPathPattern(downloadFilePathPattern))
+	if err != nil {
+		runtime.HTTPError(ctx, p.mux, outboundMarshaler, w, req, err)
 		return
 	}
 
-	runtime.ForwardResponseMessage(ctx, p.mux, outboundMarshaler, resw, req, &emptypb.Empty{}, p.mux.GetForwardResponseOptions()...)
+	ctx, err = p.verifySignedURLOfFile(ctx, req, pathParams["name"])
+	if err != nil {
+		runtime.HTTPError(ctx, p.mux, outboundMarshaler, w, req, err)
+		return
+	}
+
+	resp, err := p.filesServiceClient.GetFileHeader(ctx, &files.GetFileHeaderRequest{Name: pathParams["name"]})
+	if err != nil {
+		runtime.HTTPError(ctx, p.mux, outboundMarshaler, w, req, err)
+		return
+	}
+
+	fileHeader := resp.GetFileHeader()
+	responseHeaders := w.Header()
+	setFileHeaders(responseHeaders, fileHeader)
+
+	if notModified(req, fileHeader) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	responseHeaders.Set("content-type", fileHeader.GetContentType())
+	responseHeaders.Set("content-length", strconv.FormatUint(fileHeader.GetSize(), 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+// setFileHeaders sets headers which are same for full and partial content of file,
+// knownDigest is false if SHA-256 of file is not known before content is read.
+func setFileHeaders(h http.Header, fileHeader *files.FileHeader) (knownDigest bool) {
+	h.Set("accept-ranges", "bytes")
+	// Name is quoted and escaped, and non-ASCII name is encoded by RFC 2231.
+	if disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileHeader.GetName()}); disposition != "" {
+		h.Set("content-disposition", disposition)
+	} else {
+		h.Set("content-disposition", "attachment")
+	}
+	setValidatorHeaders(h, fileHeader)
+
+	sha256, err := hex.DecodeString(fileHeader.GetSha256())
+	if err != nil || len(sha256) == 0 {
+		return false
+	}
+	h.Set("digest", "sha-256="+base64.StdEncoding.EncodeToString(sha256))
+
+	return true
+}
+
+type headerTrackingResponseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *headerTrackingResponseWriter) WriteHeader(statusCode int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *headerTrackingResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
 }
 
 func (p *FilesServiceProxy) downloadFile(ctx context.Context, resw http.ResponseWriter, req *http.Request, pathParams map[string]string) error {
-	stream, err := p.filesServiceClient.DownloadFile(req.Context(), &files.DownloadFileRequest{
-		Name: pathParams["name"],
+	name := pathParams["name"]
+
+	ctx, err := p.verifySignedURLOfFile(ctx, req, name)
+	if err != nil {
+		return err
+	}
+
+	// Header is fetched without content only for conditional request, content stream has its own header.
+	if req.Header.Get("if-none-match") != "" || req.Header.Get("if-modified-since") != "" {
+		resp, err := p.filesServiceClient.GetFileHeader(ctx, &files.GetFileHeaderRequest{Name: name})
+		if err != nil {
+			return fmt.Errorf("get file header: %w", err)
+		}
+
+		if notModified(req, resp.GetFileHeader()) {
+			setValidatorHeaders(resw.Header(), resp.GetFileHeader())
+			resw.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
+	// Invalid Range header is ignored and whole file is sent.
+	specs, err := parseRangeHeader(req.Header.Get("range"))
+	partial := err == nil
+	if !partial {
+		specs = []rangeSpec{{start: 0, end: -1}}
+	}
+
+	first, err := p.openDownloadStream(ctx, name, specs[0].offset(), specs[0].length())
+	if err != nil {
+		return err
+	}
+	defer first.cancel()
+
+	fileHeader := first.header
+	size := int64(fileHeader.GetSize())
+
+	responseHeaders := resw.Header()
+	knownDigest := setFileHeaders(responseHeaders, fileHeader)
+
+	if !partial {
+		responseHeaders.Set("content-type", fileHeader.GetContentType())
+
+		if knownDigest {
+			responseHeaders.Set("content-length", strconv.FormatInt(size, 10))
+			resw.WriteHeader(http.StatusOK)
+
+			_, err = copyDownloadStream(resw, first.stream)
+			return err
+		}
+
+		// Digest of file is known only after content is sent, so it is sent in trailer,
+		// trailers are not sent with content length.
+		responseHeaders.Set("trailer", "digest")
+		resw.WriteHeader(http.StatusOK)
+
+		digest, err := copyDownloadStream(resw, first.stream)
+		if err != nil {
+			return err
+		}
+		if digest != nil {
+			responseHeaders.Set("digest", formatDigest(digest))
+		}
+
+		return nil
+	}
+
+	type satisfiableRange struct {
+		specIndex int
+		httpRange
+	}
+
+	ranges := make([]satisfiableRange, 0, len(specs))
+	for i := range specs {
+		if r, ok := specs[i].resolve(size); ok {
+			ranges = append(ranges, satisfiableRange{specIndex: i, httpRange: r})
+		}
+	}
+
+	if len(ranges) == 0 {
+		responseHeaders.Set("content-range", fmt.Sprintf("bytes */%d", size))
+		resw.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return nil
+	}
+
+	// Stream of first range is already opened, it is reused if first range is satisfiable.
+	openRangeStream := func(r satisfiableRange) (*downloadStream, error) {
+		if r.specIndex == 0 {
+			return first, nil
+		}
+		return p.openDownloadStream(ctx, name, r.start, uint64(r.length))
+	}
+
+	if len(ranges) == 1 {
+		stream, err := openRangeStream(ranges[0])
+		if err != nil {
+			return err
+		}
+		defer stream.cancel()
+
+		responseHeaders.Set("content-type", fileHeader.GetContentType())
+		responseHeaders.Set("content-range", ranges[0].contentRange(size))
+		responseHeaders.Set("content-length", strconv.FormatInt(ranges[0].length, 10))
+		resw.WriteHeader(http.StatusPartialContent)
+
+		_, err = copyDownloadStream(resw, stream.stream)
+		return err
+	}
+
+	multipartWriter := multipart.NewWriter(resw)
+	responseHeaders.Set("content-type", "multipart/byteranges; boundary="+multipartWriter.Boundary())
+	resw.WriteHeader(http.StatusPartialContent)
+
+	for _, r := range ranges {
+		stream, err := openRangeStream(r)
+		if err != nil {
+			return err
+		}
+
+		part, err := multipartWriter.CreatePart(textproto.MIMEHeader{
+			"Content-Type":  {fileHeader.GetContentType()},
+			"Content-Range": {r.contentRange(size)},
+		})
+		if err != nil {
+			stream.cancel()
+			return fmt.Errorf("create part of range %d-%d: %w", r.start, r.length, err)
+		}
+
+		_, err = copyDownloadStream(part, stream.stream)
+		stream.cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = multipartWriter.Close(); err != nil {
+		return fmt.Errorf("close multipart response: %w", err)
+	}
+
+	return nil
+}
+
+type downloadStream struct {
+	stream files.FilesService_DownloadFileClient
+	header *files.FileHeader
+	cancel context.CancelFunc
+}
+
+func (p *FilesServiceProxy) openDownloadStream(ctx context.Context, name string, offset int64, length uint64) (*downloadStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := p.filesServiceClient.DownloadFile(ctx, &files.DownloadFileRequest{
+		Name:   name,
+		Offset: offset,
+		Length: length,
 	})
 	if err != nil {
-		return fmt.Errorf("start stream of download file: %w", err)
+		cancel()
+		return nil, fmt.Errorf("start stream of download file: %w", err)
 	}
-	defer stream.CloseSend()
 
 	fileInfoMessage, err := stream.Recv()
 	if err != nil {
-		return fmt.Errorf("received msg with file info from stream: %w", err)
+		cancel()
+		return nil, fmt.Errorf("received msg with file info from stream: %w", err)
 	}
 
-	fileHeader := fileInfoMessage.GetFileHeader()
-	responseHeaders := resw.Header()
-	responseHeaders.Add("content-type", fileHeader.GetContentType())
-	responseHeaders.Add("content-disposition", fmt.Sprintf("%s; filename=\"%s\"", formFileName, fileHeader.GetName()))
+	return &downloadStream{
+		stream: stream,
+		header: fileInfoMessage.GetFileHeader(),
+		cancel: cancel,
+	}, nil
+}
+
+// copyDownloadStream writes content to w and returns digest of content from last message of stream.
+func copyDownloadStream(w io.Writer, stream files.FilesService_DownloadFileClient) (*files.ContentDigest, error) {
+	var digest *files.ContentDigest
 
 	for {
-		chunkMessage, err := stream.Recv()
+		message, err := stream.Recv()
 		if errors.Is(err, io.EOF) {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("received msg with file content chunk from stream: %w", err)
+			return nil, fmt.Errorf("received msg with file content chunk from stream: %w", err)
+		}
+
+		if message.GetContentDigest() != nil {
+			digest = message.GetContentDigest()
+			continue
 		}
 
-		_, err = resw.Write(chunkMessage.GetFileContentChunk())
+		_, err = w.Write(message.GetFileContentChunk())
 		if err != nil {
-			return fmt.Errorf("write chunk of file content to response: %w", err)
+			return nil, fmt.Errorf("write chunk of file content to response: %w", err)
 		}
 	}
 
-	return nil
+	return digest, nil
+}
+
+// formatDigest formats value of Digest header, CRC32C is encoded as big-endian bytes like in GCS.
+func formatDigest(digest *files.ContentDigest) string {
+	sha256, _ := hex.DecodeString(digest.GetSha256())
+
+	crc32c := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc32c, digest.GetCrc32C())
+
+	return fmt.Sprintf("sha-256=%s,crc32c=%s", base64.StdEncoding.EncodeToString(sha256), base64.StdEncoding.EncodeToString(crc32c))
+}
+
+// uploadTooLargeError is RESOURCE_EXHAUSTED like error of server about file over max size, it is sent as 413.
+func (p *FilesServiceProxy) uploadTooLargeError() error {
+	return status.Errorf(codes.ResourceExhausted, "file is larger than max size %d bytes", p.maxUploadSize)
+}
+
+// writeStatus writes status as body of response with passed HTTP status code.
+func writeStatus(w http.ResponseWriter, marshaler runtime.Marshaler, st *status.Status, httpStatus int) {
+	body, err := marshaler.Marshal(st.Proto())
+	if err != nil {
+		http.Error(w, st.Message(), httpStatus)
+		return
+	}
+
+	w.Header().Set("content-type", marshaler.ContentType(st.Proto()))
+	w.WriteHeader(httpStatus)
+	w.Write(body)
+}
+
+// verifySignedURL checks signature of URL if it is present and passes it to server as credentials
+// instead of authorization header of request. Claims are nil if URL is not signed.
+func (p *FilesServiceProxy) verifySignedURL(ctx context.Context, req *http.Request) (context.Context, *signedurl.Claims, error) {
+	token := req.URL.Query().Get(signedurl.QueryParam)
+	if token == "" {
+		return ctx, nil, nil
+	}
+	if p.signedURLSigner == nil {
+		return nil, nil, status.Error(codes.PermissionDenied, "signed urls are not configured")
+	}
+
+	claims, err := p.signedURLSigner.Verify(token)
+	if err != nil {
+		return nil, nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("authorization", "SignedURL "+token)
+
+	return metadata.NewOutgoingContext(ctx, md), &claims, nil
+}
+
+// verifySignedURLOfFile is verifySignedURL, which checks that URL is signed for method of request and file.
+func (p *FilesServiceProxy) verifySignedURLOfFile(ctx context.Context, req *http.Request, name string) (context.Context, error) {
+	signedCtx, claims, err := p.verifySignedURL(ctx, req)
+	if err != nil {
+		return ctx, err
+	}
+	if claims != nil && !claims.Allows(req.Method, name) {
+		return ctx, status.Errorf(codes.PermissionDenied, "signed url does not allow %s of %s", req.Method, name)
+	}
+
+	return signedCtx, nil
+}
+
+// grpcStatusError returns status of call wrapped by err, so gateway responds with HTTP code of status,
+// for example 403 for PERMISSION_DENIED, instead of 500.
+func grpcStatusError(err error) error {
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &statusErr) {
+		return statusErr.GRPCStatus().Err()
+	}
+	return err
 }
@@ -0,0 +1,747 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/signedurl"
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testUploadChunkSize = 4
+
+// stubFilesServer keeps files in memory and answers like files service, it records count of uploads.
+type stubFilesServer struct {
+	files.UnimplementedFilesServiceServer
+
+	mu      sync.Mutex
+	files   map[string]*stubFile
+	uploads int
+	// uploadErr is returned by upload after file info is received.
+	uploadErr error
+}
+
+type stubFile struct {
+	contentType string
+	content     []byte
+	updatedAt   time.Time
+}
+
+// stubStatusError is status error with ErrorInfo like error of files service.
+func stubStatusError(code codes.Code, reason, msg string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: files.FilesService_ServiceDesc.ServiceName})
+	if err != nil {
+		panic(err)
+	}
+	return st.Err()
+}
+
+func (s *stubFilesServer) header(name string, f *stubFile) *files.FileHeader {
+	sum := sha256.Sum256(f.content)
+	return &files.FileHeader{
+		Name:        name,
+		ContentType: f.contentType,
+		Size:        uint64(len(f.content)),
+		Sha256:      hex.EncodeToString(sum[:]),
+		Etag:        hex.EncodeToString(sum[:]),
+		UpdatedAt:   timestamppb.New(f.updatedAt),
+	}
+}
+
+func (s *stubFilesServer) file(name string) (*stubFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.files[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "file %s not found", name)
+	}
+	return f, nil
+}
+
+func (s *stubFilesServer) uploadCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.uploads
+}
+
+func (s *stubFilesServer) UploadFile(stream files.FilesService_UploadFileServer) error {
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := msg.GetFileInfo()
+
+	s.mu.Lock()
+	err = s.uploadErr
+	existing, exists := s.files[info.GetName()]
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if len(info.GetIfMatch()) > 0 && !(exists && stubETagMatches(info.GetIfMatch(), s.header(info.GetName(), existing).GetEtag())) {
+		return stubStatusError(codes.FailedPrecondition, "PRECONDITION_FAILED", "etag of file does not match")
+	}
+
+	var content bytes.Buffer
+	for {
+		msg, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		content.Write(msg.GetFileContentChunk())
+	}
+
+	f := &stubFile{contentType: info.GetContentType(), content: content.Bytes(), updatedAt: time.Now()}
+
+	s.mu.Lock()
+	s.files[info.GetName()] = f
+	s.uploads++
+	s.mu.Unlock()
+
+	return stream.SendAndClose(&files.UploadFileResponse{
+		FileHeader:      s.header(info.GetName(), f),
+		CommittedOffset: uint64(len(f.content)),
+	})
+}
+
+func stubETagMatches(etags []string, etag string) bool {
+	for _, v := range etags {
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *stubFilesServer) DownloadFile(req *files.DownloadFileRequest, stream files.FilesService_DownloadFileServer) error {
+	f, err := s.file(req.GetName())
+	if err != nil {
+		return err
+	}
+
+	offset := req.GetOffset()
+	if offset < 0 {
+		offset += int64(len(f.content))
+	}
+	content := f.content[offset:]
+	if req.GetLength() > 0 && req.GetLength() < uint64(len(content)) {
+		content = content[:req.GetLength()]
+	}
+
+	if err = stream.Send(&files.DownloadFileResponse{Data: &files.DownloadFileResponse_FileHeader{FileHeader: s.header(req.GetName(), f)}}); err != nil {
+		return err
+	}
+	for len(content) > 0 {
+		n := testUploadChunkSize
+		if n > len(content) {
+			n = len(content)
+		}
+		if err = stream.Send(&files.DownloadFileResponse{Data: &files.DownloadFileResponse_FileContentChunk{FileContentChunk: content[:n]}}); err != nil {
+			return err
+		}
+		content = content[n:]
+	}
+
+	return nil
+}
+
+func (s *stubFilesServer) GetFileHeader(_ context.Context, req *files.GetFileHeaderRequest) (*files.GetFileHeaderResponse, error) {
+	f, err := s.file(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &files.GetFileHeaderResponse{FileHeader: s.header(req.GetName(), f)}, nil
+}
+
+// testGateway is gateway with files service proxy in front of stub files server.
+type testGateway struct {
+	server *stubFilesServer
+	signer *signedurl.Signer
+	url    string
+}
+
+func newTestGateway(t *testing.T, maxUploadSize uint64) *testGateway {
+	t.Helper()
+
+	stub := &stubFilesServer{files: make(map[string]*stubFile)}
+	server := grpc.NewServer()
+	files.RegisterFilesServiceServer(server, stub)
+
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	signer, err := signedurl.NewSigner([]byte("secret"))
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+
+	mux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption("*", &gwruntime.JSONPb{}),
+		gwruntime.WithErrorHandler(RetryAfterErrorHandler),
+	)
+	NewFilesServiceProxy(files.NewFilesServiceClient(conn), mux, signer, testUploadChunkSize, maxUploadSize).RegistrationHTTP(mux)
+
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+
+	return &testGateway{server: stub, signer: signer, url: httpServer.URL}
+}
+
+func (g *testGateway) putFile(name, contentType, content string) {
+	g.server.mu.Lock()
+	defer g.server.mu.Unlock()
+
+	g.server.files[name] = &stubFile{contentType: contentType, content: []byte(content), updatedAt: time.Now()}
+}
+
+func (g *testGateway) signedQuery(t *testing.T, method, name string, ttl time.Duration) string {
+	t.Helper()
+
+	token, err := g.signer.Sign(signedurl.Claims{Method: method, Name: name, ExpiresAt: time.Now().Add(ttl).Unix()})
+	if err != nil {
+		t.Fatalf("sign url: %v", err)
+	}
+	return "?" + url.Values{signedurl.QueryParam: {token}}.Encode()
+}
+
+// uploadForm returns multipart form with file attachment and optional full name of file.
+func uploadForm(t *testing.T, name, fileName, content string) (string, *bytes.Buffer) {
+	t.Helper()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if name != "" {
+		if err := w.WriteField(formName, name); err != nil {
+			t.Fatalf("write name field: %v", err)
+		}
+	}
+	part, err := w.CreateFormFile(formFileName, fileName)
+	if err != nil {
+		t.Fatalf("create form file: %v", err)
+	}
+	part.Write([]byte(content))
+	if err = w.Close(); err != nil {
+		t.Fatalf("close form: %v", err)
+	}
+
+	return w.FormDataContentType(), &body
+}
+
+func TestFilesServiceProxy_UploadFile(t *testing.T) {
+	const content = "content of uploaded file"
+
+	tests := []struct {
+		name          string
+		maxUploadSize uint64
+		formName      string
+		fileName      string
+		content       string
+		query         func(g *testGateway) string
+		statusCode    int
+		uploadedName  string
+	}{
+		{
+			name:         "file name of attachment",
+			fileName:     "file.txt",
+			content:      content,
+			statusCode:   http.StatusOK,
+			uploadedName: "file.txt",
+		},
+		{
+			name:         "full name of form",
+			formName:     "folder/file.txt",
+			fileName:     "file.txt",
+			content:      content,
+			statusCode:   http.StatusOK,
+			uploadedName: "folder/file.txt",
+		},
+		{
+			name:          "file of max size",
+			maxUploadSize: uint64(len(content)),
+			fileName:      "file.txt",
+			content:       content,
+			statusCode:    http.StatusOK,
+			uploadedName:  "file.txt",
+		},
+		{
+			name:          "file over max size",
+			maxUploadSize: uint64(len(content)) - 1,
+			fileName:      "file.txt",
+			content:       content,
+			statusCode:    http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:          "body over max size with form overhead",
+			maxUploadSize: 1,
+			fileName:      "file.txt",
+			content:       strings.Repeat("a", maxUploadFormOverhead+2),
+			statusCode:    http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "signed url",
+			fileName:     "file.txt",
+			content:      content,
+			query:        func(g *testGateway) string { return g.signedQuery(t, http.MethodPost, "folder/file.txt", time.Minute) },
+			statusCode:   http.StatusOK,
+			uploadedName: "folder/file.txt",
+		},
+		{
+			name:       "signed url of other file",
+			formName:   "other.txt",
+			fileName:   "file.txt",
+			content:    content,
+			query:      func(g *testGateway) string { return g.signedQuery(t, http.MethodPost, "folder/file.txt", time.Minute) },
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "signed url of download",
+			fileName:   "file.txt",
+			content:    content,
+			query:      func(g *testGateway) string { return g.signedQuery(t, http.MethodGet, "file.txt", time.Minute) },
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "expired signed url",
+			fileName:   "file.txt",
+			content:    content,
+			query:      func(g *testGateway) string { return g.signedQuery(t, http.MethodPost, "file.txt", -time.Minute) },
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "forged signed url",
+			fileName:   "file.txt",
+			content:    content,
+			query:      func(*testGateway) string { return "?" + signedurl.QueryParam + "=forged.signature" },
+			statusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := newTestGateway(t, tt.maxUploadSize)
+
+			query := ""
+			if tt.query != nil {
+				query = tt.query(gateway)
+			}
+
+			contentType, body := uploadForm(t, tt.formName, tt.fileName, tt.content)
+			resp, err := http.Post(gateway.url+uploadFilePathPattern+query, contentType, body)
+			if err != nil {
+				t.Fatalf("post form: %v", err)
+			}
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.statusCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.statusCode, resp.StatusCode, respBody)
+			}
+
+			if tt.uploadedName == "" {
+				if gateway.server.uploadCount() != 0 {
+					t.Fatalf("rejected upload is sent to server")
+				}
+				return
+			}
+			f, err := gateway.server.file(tt.uploadedName)
+			if err != nil {
+				t.Fatalf("get uploaded file: %v", err)
+			}
+			if string(f.content) != tt.content {
+				t.Fatalf("expected uploaded content %q, got %q", tt.content, f.content)
+			}
+		})
+	}
+}
+
+func TestFilesServiceProxy_UploadFileContentLengthOverMaxSize(t *testing.T) {
+	gateway := newTestGateway(t, 1)
+
+	// Body is not read, so declared length is enough to reject request.
+	req, err := http.NewRequest(http.MethodPost, gateway.url+uploadFilePathPattern, strings.NewReader(strings.Repeat("a", maxUploadFormOverhead+2)))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("content-type", "multipart/form-data; boundary=x")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, resp.StatusCode)
+	}
+}
+
+func TestFilesServiceProxy_UploadFileConditional(t *testing.T) {
+	tests := []struct {
+		name       string
+		ifMatch    string
+		uploadErr  error
+		statusCode int
+		replaced   bool
+	}{
+		{name: "if-match of current etag", ifMatch: `"{etag}"`, statusCode: http.StatusOK, replaced: true},
+		{name: "if-match of any etag", ifMatch: "*", statusCode: http.StatusOK, replaced: true},
+		{name: "if-match of other etag", ifMatch: `"other"`, statusCode: http.StatusPreconditionFailed},
+		{name: "if-match of weak etag", ifMatch: `W/"{etag}"`, statusCode: http.StatusPreconditionFailed},
+		{
+			name:       "other failed precondition",
+			uploadErr:  stubStatusError(codes.FailedPrecondition, "INVALID_UPLOAD_OFFSET", "invalid offset"),
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "failed precondition without reason",
+			uploadErr:  status.Error(codes.FailedPrecondition, "failed precondition"),
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := newTestGateway(t, 0)
+			gateway.putFile("file.txt", "text/plain", "old")
+			gateway.server.mu.Lock()
+			gateway.server.uploadErr = tt.uploadErr
+			gateway.server.mu.Unlock()
+
+			old, err := gateway.server.file("file.txt")
+			if err != nil {
+				t.Fatalf("get file: %v", err)
+			}
+			etag := gateway.server.header("file.txt", old).GetEtag()
+
+			contentType, body := uploadForm(t, "", "file.txt", "new")
+			req, err := http.NewRequest(http.MethodPost, gateway.url+uploadFilePathPattern, body)
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+			req.Header.Set("content-type", contentType)
+			if tt.ifMatch != "" {
+				req.Header.Set("if-match", strings.ReplaceAll(tt.ifMatch, "{etag}", etag))
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("do request: %v", err)
+			}
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.statusCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.statusCode, resp.StatusCode, respBody)
+			}
+			if tt.statusCode == http.StatusPreconditionFailed && !strings.Contains(string(respBody), preconditionFailedReason) {
+				t.Fatalf("expected reason %s in body %s", preconditionFailedReason, respBody)
+			}
+
+			f, err := gateway.server.file("file.txt")
+			if err != nil {
+				t.Fatalf("get file: %v", err)
+			}
+			if replaced := string(f.content) == "new"; replaced != tt.replaced {
+				t.Fatalf("expected replaced %t, got content %q", tt.replaced, f.content)
+			}
+		})
+	}
+}
+
+func TestFilesServiceProxy_DownloadFile(t *testing.T) {
+	const content = "0123456789"
+
+	tests := []struct {
+		name         string
+		header       http.Header
+		statusCode   int
+		content      string
+		contentRange string
+	}{
+		{name: "whole", statusCode: http.StatusOK, content: content},
+		{name: "range", header: http.Header{"Range": {"bytes=2-5"}}, statusCode: http.StatusPartialContent, content: "2345", contentRange: "bytes 2-5/10"},
+		{name: "range to end", header: http.Header{"Range": {"bytes=7-"}}, statusCode: http.StatusPartialContent, content: "789", contentRange: "bytes 7-9/10"},
+		{name: "suffix range", header: http.Header{"Range": {"bytes=-3"}}, statusCode: http.StatusPartialContent, content: "789", contentRange: "bytes 7-9/10"},
+		{name: "range over end", header: http.Header{"Range": {"bytes=8-20"}}, statusCode: http.StatusPartialContent, content: "89", contentRange: "bytes 8-9/10"},
+		{name: "not satisfiable range", header: http.Header{"Range": {"bytes=10-"}}, statusCode: http.StatusRequestedRangeNotSatisfiable, contentRange: "bytes */10"},
+		{name: "invalid range is ignored", header: http.Header{"Range": {"bytes=5-2"}}, statusCode: http.StatusOK, content: content},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := newTestGateway(t, 0)
+			gateway.putFile("folder/file.txt", "text/plain", content)
+
+			req, err := http.NewRequest(http.MethodGet, gateway.url+"/v1/files/folder/file.txt", nil)
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("do request: %v", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.statusCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.statusCode, resp.StatusCode, body)
+			}
+			if tt.statusCode != http.StatusRequestedRangeNotSatisfiable && string(body) != tt.content {
+				t.Fatalf("expected content %q, got %q", tt.content, body)
+			}
+			if got := resp.Header.Get("content-range"); got != tt.contentRange {
+				t.Fatalf("expected content range %q, got %q", tt.contentRange, got)
+			}
+		})
+	}
+}
+
+func TestFilesServiceProxy_DownloadFileMultipleRanges(t *testing.T) {
+	gateway := newTestGateway(t, 0)
+	gateway.putFile("file.txt", "text/plain", "0123456789")
+
+	req, err := http.NewRequest(http.MethodGet, gateway.url+"/v1/files/file.txt", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("range", "bytes=0-1,20-30,-2")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("content-type"))
+	if resp.StatusCode != http.StatusPartialContent || err != nil || mediaType != "multipart/byteranges" {
+		t.Fatalf("expected multipart partial content, got %d %q", resp.StatusCode, resp.Header.Get("content-type"))
+	}
+
+	// Not satisfiable range is skipped.
+	var parts []string
+	reader := multipart.NewReader(resp.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		content, _ := io.ReadAll(part)
+		parts = append(parts, fmt.Sprintf("%s %s", part.Header.Get("content-range"), content))
+	}
+
+	if got := strings.Join(parts, ", "); got != "bytes 0-1/10 01, bytes 8-9/10 89" {
+		t.Fatalf("unexpected parts %s", got)
+	}
+}
+
+func TestFilesServiceProxy_DownloadFileConditional(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     http.Header
+		statusCode int
+	}{
+		{name: "if-none-match of current etag", header: http.Header{"If-None-Match": {`"{etag}"`}}, statusCode: http.StatusNotModified},
+		{name: "if-none-match of weak current etag", header: http.Header{"If-None-Match": {`W/"{etag}"`}}, statusCode: http.StatusNotModified},
+		{name: "if-none-match of list with current etag", header: http.Header{"If-None-Match": {`"other", "{etag}"`}}, statusCode: http.StatusNotModified},
+		{name: "if-none-match of any etag", header: http.Header{"If-None-Match": {"*"}}, statusCode: http.StatusNotModified},
+		{name: "if-none-match of other etag", header: http.Header{"If-None-Match": {`"other"`}}, statusCode: http.StatusOK},
+		{name: "if-modified-since modification", header: http.Header{"If-Modified-Since": {"{modified}"}}, statusCode: http.StatusNotModified},
+		{name: "if-modified-since before modification", header: http.Header{"If-Modified-Since": {"{before}"}}, statusCode: http.StatusOK},
+		{
+			name:       "if-modified-since is ignored with if-none-match",
+			header:     http.Header{"If-None-Match": {`"other"`}, "If-Modified-Since": {"{modified}"}},
+			statusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, method := range []string{http.MethodGet, http.MethodHead} {
+			t.Run(method+" "+tt.name, func(t *testing.T) {
+				gateway := newTestGateway(t, 0)
+				gateway.putFile("file.txt", "text/plain", "content")
+
+				f, err := gateway.server.file("file.txt")
+				if err != nil {
+					t.Fatalf("get file: %v", err)
+				}
+				replacer := strings.NewReplacer(
+					"{etag}", gateway.server.header("file.txt", f).GetEtag(),
+					"{modified}", f.updatedAt.UTC().Format(http.TimeFormat),
+					"{before}", f.updatedAt.Add(-time.Hour).UTC().Format(http.TimeFormat),
+				)
+
+				req, err := http.NewRequest(method, gateway.url+"/v1/files/file.txt", nil)
+				if err != nil {
+					t.Fatalf("new request: %v", err)
+				}
+				for k, v := range tt.header {
+					req.Header.Set(k, replacer.Replace(v[0]))
+				}
+
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					t.Fatalf("do request: %v", err)
+				}
+				body, _ := io.ReadAll(resp.Body)
+				resp.Body.Close()
+
+				if resp.StatusCode != tt.statusCode {
+					t.Fatalf("expected status %d, got %d: %s", tt.statusCode, resp.StatusCode, body)
+				}
+				if resp.Header.Get("etag") == "" || resp.Header.Get("last-modified") == "" {
+					t.Fatalf("expected validators in response, got %v", resp.Header)
+				}
+				switch {
+				case tt.statusCode == http.StatusNotModified || method == http.MethodHead:
+					if len(body) != 0 {
+						t.Fatalf("expected empty body, got %q", body)
+					}
+				case string(body) != "content":
+					t.Fatalf("expected content, got %q", body)
+				}
+			})
+		}
+	}
+}
+
+func TestFilesServiceProxy_DownloadFileContentDisposition(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+	}{
+		{name: "plain", fileName: "file.txt"},
+		{name: "quote and backslash", fileName: `a "quoted" \ name.txt`},
+		{name: "semicolon", fileName: "a; filename=evil.exe"},
+		{name: "non ascii", fileName: "файл.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := newTestGateway(t, 0)
+			gateway.putFile(tt.fileName, "text/plain", "content")
+
+			resp, err := http.Get(gateway.url + "/v1/files/" + url.PathEscape(tt.fileName))
+			if err != nil {
+				t.Fatalf("get file: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+
+			disposition, params, err := mime.ParseMediaType(resp.Header.Get("content-disposition"))
+			if err != nil {
+				t.Fatalf("parse content disposition %q: %v", resp.Header.Get("content-disposition"), err)
+			}
+			if disposition != "attachment" || params["filename"] != tt.fileName || len(params) != 1 {
+				t.Fatalf("unexpected content disposition %q", resp.Header.Get("content-disposition"))
+			}
+		})
+	}
+}
+
+func TestFilesServiceProxy_DownloadFileSignedURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		query      func(g *testGateway) string
+		statusCode int
+	}{
+		{name: "download", method: http.MethodGet, query: func(g *testGateway) string { return g.signedQuery(t, http.MethodGet, "file.txt", time.Minute) }, statusCode: http.StatusOK},
+		{name: "head by download url", method: http.MethodHead, query: func(g *testGateway) string { return g.signedQuery(t, http.MethodGet, "file.txt", time.Minute) }, statusCode: http.StatusOK},
+		{name: "other file", method: http.MethodGet, query: func(g *testGateway) string { return g.signedQuery(t, http.MethodGet, "other.txt", time.Minute) }, statusCode: http.StatusForbidden},
+		{name: "upload url", method: http.MethodGet, query: func(g *testGateway) string { return g.signedQuery(t, http.MethodPost, "file.txt", time.Minute) }, statusCode: http.StatusForbidden},
+		{name: "expired", method: http.MethodGet, query: func(g *testGateway) string { return g.signedQuery(t, http.MethodGet, "file.txt", -time.Minute) }, statusCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := newTestGateway(t, 0)
+			gateway.putFile("file.txt", "text/plain", "content")
+
+			req, err := http.NewRequest(tt.method, gateway.url+"/v1/files/file.txt"+tt.query(gateway), nil)
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("do request: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.statusCode {
+				t.Fatalf("expected status %d, got %d", tt.statusCode, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestFilesServiceProxy_NotFound(t *testing.T) {
+	gateway := newTestGateway(t, 0)
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		req, err := http.NewRequest(method, gateway.url+"/v1/files/missing.txt", nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("do request: %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected status %d of %s, got %d", http.StatusNotFound, method, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	healthzPath = "/healthz"
+	readyzPath  = "/readyz"
+)
+
+// HealthHandler serves probes of gateway. Gateway is live while it handles requests, it is ready while
+// connection to grpc server is ready and server reports that files service is serving.
+type HealthHandler struct {
+	mux          *runtime.ServeMux
+	conn         *grpc.ClientConn
+	healthClient healthpb.HealthClient
+	checkTimeout time.Duration
+}
+
+func NewHealthHandler(mux *runtime.ServeMux, conn *grpc.ClientConn, checkTimeout time.Duration) *HealthHandler {
+	return &HealthHandler{
+		mux:          mux,
+		conn:         conn,
+		healthClient: healthpb.NewHealthClient(conn),
+		checkTimeout: checkTimeout,
+	}
+}
+
+func (h *HealthHandler) RegistrationHTTP(mux *runtime.ServeMux) {
+	mux.HandlePath(http.MethodGet, healthzPath, h.Healthz)
+	mux.HandlePath(http.MethodGet, readyzPath, h.Readyz)
+}
+
+func (h *HealthHandler) Healthz(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(h.mux, req)
+	writeStatus(w, outboundMarshaler, status.New(codes.OK, "alive"), http.StatusOK)
+}
+
+func (h *HealthHandler) Readyz(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(h.mux, req)
+
+	if err := h.checkServer(req.Context()); err != nil {
+		writeStatus(w, outboundMarshaler, status.Convert(err), http.StatusServiceUnavailable)
+		return
+	}
+
+	writeStatus(w, outboundMarshaler, status.New(codes.OK, "ready"), http.StatusOK)
+}
+
+func (h *HealthHandler) checkServer(ctx context.Context) error {
+	state := h.conn.GetState()
+	if state == connectivity.Idle {
+		// Idle connection is connected only by calls, so it is connected for next probe.
+		h.conn.Connect()
+	}
+	if state != connectivity.Ready {
+		return status.Errorf(codes.Unavailable, "connection to grpc server is %s", state)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.checkTimeout)
+	defer cancel()
+
+	resp, err := h.healthClient.Check(ctx, &healthpb.HealthCheckRequest{
+		Service: files.FilesService_ServiceDesc.ServiceName,
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "check health of grpc server: %s", status.Convert(err).Message())
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return status.Errorf(codes.Unavailable, "grpc server is %s", resp.GetStatus())
+	}
+
+	return nil
+}
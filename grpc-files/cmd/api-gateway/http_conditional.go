@@ -0,0 +1,128 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// preconditionFailedReason is reason of ErrorInfo of FAILED_PRECONDITION status of files service,
+// when If-Match condition of request is not met.
+const preconditionFailedReason = "PRECONDITION_FAILED"
+
+// entityTag is one tag of If-Match or If-None-Match header, value "*" matches any entity.
+type entityTag struct {
+	value string
+	weak  bool
+}
+
+func formatETag(value string) string {
+	return `"` + value + `"`
+}
+
+// parseETags parses list of entity tags, invalid tags are skipped.
+func parseETags(header string) []entityTag {
+	var tags []entityTag
+
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" {
+			tags = append(tags, entityTag{value: "*"})
+			continue
+		}
+
+		tag := entityTag{}
+		if strings.HasPrefix(v, "W/") {
+			tag.weak = true
+			v = v[2:]
+		}
+		if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+			continue
+		}
+		tag.value = v[1 : len(v)-1]
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// ifMatchETags returns etags of If-Match header for UploadFileRequest.Info, weak tags never match
+// by strong comparison, so they are dropped.
+func ifMatchETags(header string) (etags []string, ok bool) {
+	if header == "" {
+		return nil, false
+	}
+
+	etags = make([]string, 0)
+	for _, tag := range parseETags(header) {
+		if !tag.weak {
+			etags = append(etags, tag.value)
+		}
+	}
+
+	return etags, true
+}
+
+// preconditionFailedError is FAILED_PRECONDITION status of not met If-Match condition like error of files service.
+func preconditionFailedError(msg string) error {
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: preconditionFailedReason,
+		Domain: files.FilesService_ServiceDesc.ServiceName,
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
+
+// isPreconditionFailed reports whether status is not met If-Match condition. Other FAILED_PRECONDITION
+// statuses, for example not empty folder, are not about conditional request.
+func isPreconditionFailed(st *status.Status) bool {
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if errorInfo, ok := detail.(*errdetails.ErrorInfo); ok {
+			return errorInfo.GetReason() == preconditionFailedReason
+		}
+	}
+	return false
+}
+
+// notModified checks If-None-Match and If-Modified-Since headers of GET request by RFC 7232,
+// If-Modified-Since is ignored when If-None-Match is present.
+func notModified(req *http.Request, fileHeader *files.FileHeader) bool {
+	if header := req.Header.Get("if-none-match"); header != "" {
+		for _, tag := range parseETags(header) {
+			// Weak comparison is used for If-None-Match.
+			if tag.value == "*" || tag.value == fileHeader.GetEtag() {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(req.Header.Get("if-modified-since"))
+	if err != nil || fileHeader.GetUpdatedAt() == nil {
+		return false
+	}
+
+	// Last-Modified has precision of seconds.
+	return !fileHeader.GetUpdatedAt().AsTime().Truncate(time.Second).After(since)
+}
+
+// setValidatorHeaders sets ETag and Last-Modified of file.
+func setValidatorHeaders(h http.Header, fileHeader *files.FileHeader) {
+	if fileHeader.GetEtag() != "" {
+		h.Set("etag", formatETag(fileHeader.GetEtag()))
+	}
+	if fileHeader.GetUpdatedAt() != nil {
+		h.Set("last-modified", fileHeader.GetUpdatedAt().AsTime().UTC().Format(http.TimeFormat))
+	}
+}
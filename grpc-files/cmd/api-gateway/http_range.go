@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const maxRangesInRequest = 16
+
+var errInvalidRangeHeader = errors.New("invalid range header")
+
+// rangeSpec is one range of Range header: "start-end", "start-" or "-suffixLength".
+type rangeSpec struct {
+	start int64 // -1 for suffix range.
+	end   int64 // -1 for range to end of file, for suffix range it is length of suffix.
+}
+
+// offset and length in terms of DownloadFileRequest.
+func (r rangeSpec) offset() int64 {
+	if r.start < 0 {
+		return -r.end
+	}
+	return r.start
+}
+
+func (r rangeSpec) length() uint64 {
+	if r.start < 0 || r.end < 0 {
+		return 0
+	}
+	return uint64(r.end - r.start + 1)
+}
+
+// resolve returns position of range in file with passed size, ok is false if range is not satisfiable.
+func (r rangeSpec) resolve(size int64) (res httpRange, ok bool) {
+	switch {
+	case r.start < 0:
+		if r.end == 0 || size == 0 {
+			return httpRange{}, false
+		}
+		if r.end > size {
+			r.end = size
+		}
+		return httpRange{start: size - r.end, length: r.end}, true
+	case r.start >= size:
+		return httpRange{}, false
+	case r.end < 0 || r.end >= size:
+		return httpRange{start: r.start, length: size - r.start}, true
+	default:
+		return httpRange{start: r.start, length: r.end - r.start + 1}, true
+	}
+}
+
+type httpRange struct {
+	start  int64
+	length int64
+}
+
+func (r httpRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.start, r.start+r.length-1, size)
+}
+
+func parseRangeHeader(header string) ([]rangeSpec, error) {
+	const unitPrefix = "bytes="
+
+	if !strings.HasPrefix(header, unitPrefix) {
+		return nil, fmt.Errorf("%w: unsupported unit", errInvalidRangeHeader)
+	}
+
+	items := strings.Split(header[len(unitPrefix):], ",")
+	if len(items) > maxRangesInRequest {
+		return nil, fmt.Errorf("%w: too many ranges", errInvalidRangeHeader)
+	}
+
+	specs := make([]rangeSpec, 0, len(items))
+
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		first, last, ok := strings.Cut(item, "-")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errInvalidRangeHeader, item)
+		}
+
+		spec := rangeSpec{start: -1, end: -1}
+		var err error
+
+		if first != "" {
+			if spec.start, err = strconv.ParseInt(first, 10, 64); err != nil || spec.start < 0 {
+				return nil, fmt.Errorf("%w: %q", errInvalidRangeHeader, item)
+			}
+		}
+		if last != "" {
+			if spec.end, err = strconv.ParseInt(last, 10, 64); err != nil || spec.end < 0 {
+				return nil, fmt.Errorf("%w: %q", errInvalidRangeHeader, item)
+			}
+		}
+
+		if (first == "" && last == "") || (first != "" && last != "" && spec.end < spec.start) {
+			return nil, fmt.Errorf("%w: %q", errInvalidRangeHeader, item)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("%w: no ranges", errInvalidRangeHeader)
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRangeHeader(t *testing.T) {
+	tests := []struct {
+		header string
+		specs  []rangeSpec
+	}{
+		{header: "bytes=0-99", specs: []rangeSpec{{start: 0, end: 99}}},
+		{header: "bytes=100-", specs: []rangeSpec{{start: 100, end: -1}}},
+		{header: "bytes=-100", specs: []rangeSpec{{start: -1, end: 100}}},
+		{header: "bytes=5-5", specs: []rangeSpec{{start: 5, end: 5}}},
+		{header: "bytes=0-0, -1", specs: []rangeSpec{{start: 0, end: 0}, {start: -1, end: 1}}},
+		{header: "bytes=0-1,,2-3", specs: []rangeSpec{{start: 0, end: 1}, {start: 2, end: 3}}},
+		{header: "bytes=" + strings.Repeat("0-1,", maxRangesInRequest-1) + "0-1", specs: repeatRangeSpec(rangeSpec{start: 0, end: 1}, maxRangesInRequest)},
+		{header: ""},
+		{header: "items=0-1"},
+		{header: "bytes="},
+		{header: "bytes=,"},
+		{header: "bytes=-"},
+		{header: "bytes=1"},
+		{header: "bytes=a-1"},
+		{header: "bytes=1-a"},
+		{header: "bytes=--1"},
+		{header: "bytes=1--2"},
+		{header: "bytes=5-4"},
+		{header: "bytes=99999999999999999999-"},
+		{header: "bytes=" + strings.Repeat("0-1,", maxRangesInRequest) + "0-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			specs, err := parseRangeHeader(tt.header)
+			if tt.specs == nil {
+				if !errors.Is(err, errInvalidRangeHeader) {
+					t.Fatalf("expected errInvalidRangeHeader, got specs %v and error %v", specs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse range header: %v", err)
+			}
+			if !reflect.DeepEqual(specs, tt.specs) {
+				t.Fatalf("expected specs %v, got %v", tt.specs, specs)
+			}
+		})
+	}
+}
+
+func repeatRangeSpec(spec rangeSpec, count int) []rangeSpec {
+	specs := make([]rangeSpec, count)
+	for i := range specs {
+		specs[i] = spec
+	}
+	return specs
+}
+
+func TestRangeSpec_Resolve(t *testing.T) {
+	const size = 100
+
+	tests := []struct {
+		name         string
+		spec         rangeSpec
+		satisfiable  bool
+		res          httpRange
+		contentRange string
+	}{
+		{name: "start and end", spec: rangeSpec{start: 10, end: 19}, satisfiable: true, res: httpRange{start: 10, length: 10}, contentRange: "bytes 10-19/100"},
+		{name: "end after size", spec: rangeSpec{start: 90, end: 200}, satisfiable: true, res: httpRange{start: 90, length: 10}, contentRange: "bytes 90-99/100"},
+		{name: "to end", spec: rangeSpec{start: 50, end: -1}, satisfiable: true, res: httpRange{start: 50, length: 50}, contentRange: "bytes 50-99/100"},
+		{name: "suffix", spec: rangeSpec{start: -1, end: 10}, satisfiable: true, res: httpRange{start: 90, length: 10}, contentRange: "bytes 90-99/100"},
+		{name: "suffix longer than size", spec: rangeSpec{start: -1, end: 200}, satisfiable: true, res: httpRange{start: 0, length: 100}, contentRange: "bytes 0-99/100"},
+		{name: "empty suffix", spec: rangeSpec{start: -1, end: 0}},
+		{name: "start at size", spec: rangeSpec{start: 100, end: -1}},
+		{name: "start after size", spec: rangeSpec{start: 150, end: 160}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, ok := tt.spec.resolve(size)
+			if ok != tt.satisfiable {
+				t.Fatalf("expected satisfiable %t, got %t", tt.satisfiable, ok)
+			}
+			if !ok {
+				return
+			}
+			if res != tt.res {
+				t.Fatalf("expected range %+v, got %+v", tt.res, res)
+			}
+			if contentRange := res.contentRange(size); contentRange != tt.contentRange {
+				t.Fatalf("expected content range %q, got %q", tt.contentRange, contentRange)
+			}
+		})
+	}
+}
+
+func TestRangeSpec_ResolveEmptyFile(t *testing.T) {
+	for _, spec := range []rangeSpec{{start: 0, end: -1}, {start: 0, end: 0}, {start: -1, end: 1}} {
+		if _, ok := spec.resolve(0); ok {
+			t.Fatalf("range %+v of empty file is satisfiable", spec)
+		}
+	}
+}
+
+func TestRangeSpec_OffsetAndLength(t *testing.T) {
+	tests := []struct {
+		spec   rangeSpec
+		offset int64
+		length uint64
+	}{
+		{spec: rangeSpec{start: 10, end: 19}, offset: 10, length: 10},
+		{spec: rangeSpec{start: 10, end: -1}, offset: 10, length: 0},
+		{spec: rangeSpec{start: -1, end: 10}, offset: -10, length: 0},
+	}
+
+	for _, tt := range tests {
+		if offset, length := tt.spec.offset(), tt.spec.length(); offset != tt.offset || length != tt.length {
+			t.Fatalf("expected offset %d and length %d of %+v, got %d and %d", tt.offset, tt.length, tt.spec, offset, length)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"errors"
+	"io"
+)
+
+var errBodyTooLarge = errors.New("body of request is too large")
+
+// limitedBody is body of request, which returns errBodyTooLarge after limit is read. It is like
+// http.MaxBytesReader, but it reports that limit is exceeded, even if error is wrapped by multipart reader.
+type limitedBody struct {
+	io.ReadCloser
+	// remaining is count of bytes, which can be read.
+	remaining int64
+	exceeded  bool
+}
+
+func newLimitedBody(body io.ReadCloser, limit int64) *limitedBody {
+	return &limitedBody{ReadCloser: body, remaining: limit}
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.exceeded {
+		return 0, errBodyTooLarge
+	}
+
+	// One byte over limit is read, so body of exact limit size is not rejected.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		b.exceeded = true
+		return int(b.remaining), errBodyTooLarge
+	}
+	b.remaining -= int64(n)
+
+	return n, err
+}
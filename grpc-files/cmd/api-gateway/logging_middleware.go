@@ -0,0 +1,52 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// LoggingMiddleware takes id of request from X-Request-Id header of client or generates it, echoes it in response,
+// puts it with logger of request to context and writes access log of request with sizes of bodies and duration.
+// Query is not logged, because it has tokens of signed URLs. Aborted responses are logged as errors too.
+func LoggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestID := logging.RequestID(req.Header.Get(logging.RequestIDHeader))
+		w.Header().Set(logging.RequestIDHeader, requestID)
+
+		logger := logging.FromContext(req.Context()).WithField("request_id", requestID)
+		ctx := logging.ContextWithLogger(logging.ContextWithRequestID(req.Context(), requestID), logger)
+
+		body := &countingReadCloser{ReadCloser: req.Body}
+		req = req.WithContext(ctx)
+		req.Body = body
+
+		metrics, aborted := captureMetrics(next, w, req)
+
+		entry := logger.WithFields(logrus.Fields{
+			"method":           req.Method,
+			"path":             req.URL.Path,
+			"code":             metrics.Code,
+			"received_bytes":   body.n,
+			"sent_bytes":       metrics.Written,
+			"duration_seconds": metrics.Duration.Seconds(),
+		})
+		if aborted != nil {
+			entry = entry.WithField("aborted", true)
+		}
+		switch {
+		case aborted != nil || metrics.Code >= http.StatusInternalServerError:
+			entry.Error("request")
+		case req.URL.Path == healthzPath || req.URL.Path == readyzPath || req.URL.Path == metricsPath:
+			// Probes and scrapes are frequent, they are logged only with debug level.
+			entry.Debug("request")
+		default:
+			entry.Info("request")
+		}
+
+		if aborted != nil {
+			panic(aborted)
+		}
+	})
+}
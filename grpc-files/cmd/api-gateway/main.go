@@ -2,61 +2,181 @@ package main
 
 import (
 	"context"
-	"flag"
 	"fmt"
-	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/config"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/signedurl"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/tlsconfig"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/tracing"
 	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	// Details of errors of server are registered, so they are marshaled to JSON bodies of errors.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
-const tcpAddress = "0.0.0.0:8080"
+const (
+	tracingServiceName  = "files-gateway"
+	tracingFlushTimeout = time.Second * 5
+	metricsPath         = "/metrics"
+)
 
 func main() {
-	serverAddr := flag.String("server-address", "localhost:9000", "address of grpc server")
-	dialTimeout := flag.Duration("dial-timeout", time.Second*30, "timeout of wait dial connect to server")
-	flag.Parse()
+	cfg := DefaultConfig()
+	printConfig, err := config.Load(&cfg, "GATEWAY_CONFIG_FILE", os.Args[1:])
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	if printConfig {
+		if err = config.Print(os.Stdout, &cfg); err != nil {
+			logrus.Fatal(err)
+		}
+		return
+	}
+	if err = logging.Setup(cfg.Log); err != nil {
+		logrus.Fatal(err)
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if serverTLS := cfg.Server.TLS; serverTLS.IsEnabled() {
+		tlsConfig, err := tlsconfig.LoadClientConfig(serverTLS.CAFile, serverTLS.CertFile, serverTLS.KeyFile, serverTLS.Name, logrus.StandardLogger())
+		if err != nil {
+			logrus.Fatal(fmt.Errorf("load tls config of grpc client: %w", err))
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
 
 	ctx := context.Background()
-	dialCtx, cancelDial := context.WithTimeout(ctx, *dialTimeout)
+
+	shutdownTracing, err := tracing.Setup(ctx, tracingServiceName, cfg.Tracing)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	dialCtx, cancelDial := context.WithTimeout(ctx, cfg.Server.DialTimeout)
 	defer cancelDial()
 
 	conn, err := grpc.DialContext(
 		dialCtx,
-		*serverAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cfg.Server.Address,
+		grpc.WithTransportCredentials(transportCredentials),
 		grpc.WithBlock(),
+		// Trace context of request, which is kept by runtime.AnnotateContext, is sent to server.
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	)
 	if err != nil {
-		log.Fatalln(fmt.Errorf("failed connect to grpc server: %w", err))
+		logrus.Fatal(fmt.Errorf("failed connect to grpc server: %w", err))
 	}
 	defer conn.Close()
 
 	marshler := &gwruntime.JSONPb{}
-	mux := gwruntime.NewServeMux(gwruntime.WithMarshalerOption("*", marshler))
+	mux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption("*", marshler),
+		gwruntime.WithErrorHandler(RetryAfterErrorHandler),
+		// Id of request is sent to server, so log lines of gateway and server are joined by it.
+		gwruntime.WithMetadata(func(ctx context.Context, _ *http.Request) metadata.MD {
+			return metadata.Pairs(logging.RequestIDHeader, logging.RequestIDFromContext(ctx))
+		}),
+	)
 
 	filesServiceClient := files.NewFilesServiceClient(conn)
-	filesServiceProxy := NewFilesServiceProxy(filesServiceClient, mux)
+	var signedURLSigner *signedurl.Signer
+	if cfg.SignedURLSecret != "" {
+		if signedURLSigner, err = signedurl.NewSigner([]byte(cfg.SignedURLSecret)); err != nil {
+			logrus.Fatal(err)
+		}
+	}
 
+	filesServiceProxy := NewFilesServiceProxy(filesServiceClient, mux, signedURLSigner, cfg.UploadChunkSize, cfg.MaxUploadSize)
+
+	// Прокси регистрируется первым, так как обработчики, зарегистрированные позже, проверяются раньше,
+	// иначе путь скачивания файла "/v1/files/{name=**}" перекроет путь списка файлов "/v1/files".
+	filesServiceProxy.RegistrationHTTP(mux)
 	files.RegisterFilesServiceHandlerClient(context.TODO(), mux, filesServiceClient)
-	filesServiceProxy.RegistrationHTTP(mux) // Для того чтобы переопределить методы основного FilesServiceGateway.
+	NewHealthHandler(mux, conn, cfg.HealthCheckTimeout).RegistrationHTTP(mux)
 
-	tcpListener, err := net.Listen("tcp", tcpAddress)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	metricsMiddleware, err := NewMetricsMiddleware(registry)
 	if err != nil {
-		log.Fatalln(err)
+		logrus.Fatal(err)
+	}
+	metricsHandler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	mux.HandlePath(http.MethodGet, metricsPath, func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		metricsHandler.ServeHTTP(w, req)
+	})
+
+	// Span of request is started from trace context of client, if it is sent by traceparent header.
+	handler := otelhttp.NewHandler(LoggingMiddleware(metricsMiddleware.Handler(mux)), tracingServiceName,
+		otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+			return req.Method + " " + metricsRoute(req.URL.Path)
+		}),
+	)
+
+	tcpListener, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		logrus.Fatal(err)
 	}
 	defer tcpListener.Close()
 
-	server := &http.Server{Handler: mux}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: cfg.ReadHeaderTimeout}
 
-	log.Println("listen", tcpAddress)
+	if cfg.TLS.CertFile != "" {
+		if server.TLSConfig, err = tlsconfig.LoadServerConfig(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile, cfg.TLS.RequireClientCert, logrus.StandardLogger()); err != nil {
+			logrus.Fatal(fmt.Errorf("load tls config: %w", err))
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if server.TLSConfig == nil {
+			logrus.WithField("address", cfg.Address).Info("listen")
+			serveErr <- server.Serve(tcpListener)
+			return
+		}
+		logrus.WithField("address", cfg.Address).Info("listen tls")
+		// Certificate is got from config, so it is reloaded when files are changed.
+		serveErr <- server.ServeTLS(tcpListener, "", "")
+	}()
+
+	select {
+	case err = <-serveErr:
+		logrus.Fatal(err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	logrus.WithField("timeout", cfg.ShutdownTimeout.String()).Info("shutdown, drain requests")
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancelShutdown()
+	if err = server.Shutdown(shutdownCtx); err != nil {
+		logrus.WithError(err).Warn("drain timeout is exceeded, running requests are closed")
+		server.Close()
+	}
 
-	if err = server.Serve(tcpListener); err != nil {
-		log.Fatalln(err)
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), tracingFlushTimeout)
+	defer cancelFlush()
+	if err = shutdownTracing(flushCtx); err != nil {
+		logrus.WithError(err).Error("flush spans")
 	}
 }
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsMiddleware counts requests by routes, methods and codes, measures their latencies, bytes of bodies
+// and count of requests in flight. Route is first segments of path, so names of files do not make labels.
+type MetricsMiddleware struct {
+	requests  *prometheus.CounterVec
+	latency   *prometheus.HistogramVec
+	bodyBytes *prometheus.CounterVec
+	inFlight  *prometheus.GaugeVec
+}
+
+func NewMetricsMiddleware(registerer prometheus.Registerer) (*MetricsMiddleware, error) {
+	m := MetricsMiddleware{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Count of finished requests by routes, methods and codes, code of aborted responses is \"aborted\".",
+		}, []string{"route", "method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Latency of requests by routes and methods until response body is written.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"route", "method"}),
+		bodyBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_body_bytes_total",
+			Help: "Size of bodies of requests and responses by routes and directions, received or sent.",
+		}, []string{"route", "direction"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Count of running requests by routes.",
+		}, []string{"route"}),
+	}
+
+	for _, collector := range []prometheus.Collector{m.requests, m.latency, m.bodyBytes, m.inFlight} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return &m, nil
+}
+
+func (m *MetricsMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		route := metricsRoute(req.URL.Path)
+
+		inFlight := m.inFlight.WithLabelValues(route)
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		body := &countingReadCloser{ReadCloser: req.Body}
+		req.Body = body
+
+		metrics, aborted := captureMetrics(next, w, req)
+
+		code := strconv.Itoa(metrics.Code)
+		if aborted != nil {
+			code = "aborted"
+		}
+		m.requests.WithLabelValues(route, req.Method, code).Inc()
+		m.latency.WithLabelValues(route, req.Method).Observe(metrics.Duration.Seconds())
+		m.bodyBytes.WithLabelValues(route, "received").Add(float64(body.n))
+		m.bodyBytes.WithLabelValues(route, "sent").Add(float64(metrics.Written))
+
+		if aborted != nil {
+			panic(aborted)
+		}
+	})
+}
+
+// captureMetrics serves request and returns its metrics and value of panic of handler, which is not nil
+// if response is aborted, like download broken by http.ErrAbortHandler. Caller panics with it again
+// after request is recorded. Writer is wrapped by httpsnoop, so flushing of streamed downloads is kept.
+func captureMetrics(next http.Handler, w http.ResponseWriter, req *http.Request) (metrics httpsnoop.Metrics, aborted interface{}) {
+	metrics.Code = http.StatusOK
+	start := time.Now()
+	defer func() {
+		aborted = recover()
+		metrics.Duration = time.Since(start)
+	}()
+
+	metrics.CaptureMetrics(w, func(w http.ResponseWriter) {
+		next.ServeHTTP(w, req)
+	})
+
+	return metrics, nil
+}
+
+// metricsCollections are collections of API, which are routes of metrics.
+var metricsCollections = map[string]struct{}{
+	"files":   {},
+	"folders": {},
+	"uploads": {},
+	"usage":   {},
+}
+
+// metricsRoute returns "/v1/<collection>" for API paths and path itself for service paths,
+// other paths are "other", so unknown paths do not make labels too.
+func metricsRoute(path string) string {
+	switch path {
+	case healthzPath, readyzPath, metricsPath:
+		return path
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(segments) >= 2 && segments[0] == "v1" {
+		collection, _, _ := strings.Cut(segments[1], ":")
+		if _, ok := metricsCollections[collection]; ok {
+			return "/v1/" + collection
+		}
+	}
+
+	return "other"
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	n int64
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
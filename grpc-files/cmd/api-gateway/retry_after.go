@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+const retryAfterHeader = "Retry-After"
+
+// RetryAfterErrorHandler is default error handler of gateway, which sets Retry-After header
+// from RetryInfo of status, so rate limited clients know when to retry.
+func RetryAfterErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if st, ok := status.FromError(err); ok {
+		if delay, ok := retryAfter(st); ok {
+			w.Header().Set(retryAfterHeader, strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// retryAfter returns delay of RetryInfo of status, it returns false if status has no RetryInfo.
+func retryAfter(st *status.Status) (time.Duration, bool) {
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok && retryInfo.GetRetryDelay() != nil {
+			return retryInfo.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// isRetryable reports whether status has RetryInfo.
+func isRetryable(st *status.Status) bool {
+	_, ok := retryAfter(st)
+	return ok
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// retryStatusError is status of code with RetryInfo of delay.
+func retryStatusError(code codes.Code, delay time.Duration) error {
+	st, err := status.New(code, "retry later").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		panic(err)
+	}
+	return st.Err()
+}
+
+func TestRetryAfterErrorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		retryAfter string
+	}{
+		{name: "rate limited", err: retryStatusError(codes.ResourceExhausted, 2*time.Second), statusCode: http.StatusTooManyRequests, retryAfter: "2"},
+		{name: "delay is rounded up", err: retryStatusError(codes.ResourceExhausted, 1200*time.Millisecond), statusCode: http.StatusTooManyRequests, retryAfter: "2"},
+		{name: "unavailable with retry info", err: retryStatusError(codes.Unavailable, time.Second), statusCode: http.StatusServiceUnavailable, retryAfter: "1"},
+		{name: "resource exhausted without retry info", err: status.Error(codes.ResourceExhausted, "quota exceeded"), statusCode: http.StatusTooManyRequests},
+		{name: "other error", err: status.Error(codes.NotFound, "file not found"), statusCode: http.StatusNotFound},
+	}
+
+	mux := gwruntime.NewServeMux()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/v1/files", nil)
+
+			RetryAfterErrorHandler(context.Background(), mux, &gwruntime.JSONPb{}, w, r, tt.err)
+
+			if w.Code != tt.statusCode {
+				t.Fatalf("expected status %d, got %d", tt.statusCode, w.Code)
+			}
+			if got := w.Header().Get(retryAfterHeader); got != tt.retryAfter {
+				t.Fatalf("expected Retry-After %q, got %q", tt.retryAfter, got)
+			}
+		})
+	}
+}
+
+func TestFilesServiceProxy_UploadFileResourceExhausted(t *testing.T) {
+	tests := []struct {
+		name       string
+		uploadErr  error
+		statusCode int
+		retryAfter string
+	}{
+		{name: "rate limited", uploadErr: retryStatusError(codes.ResourceExhausted, 3*time.Second), statusCode: http.StatusTooManyRequests, retryAfter: "3"},
+		{name: "file over limit", uploadErr: status.Error(codes.ResourceExhausted, "quota exceeded"), statusCode: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := newTestGateway(t, 0)
+			gateway.server.mu.Lock()
+			gateway.server.uploadErr = tt.uploadErr
+			gateway.server.mu.Unlock()
+
+			contentType, body := uploadForm(t, "", "file.txt", "content")
+			resp, err := http.Post(gateway.url+uploadFilePathPattern, contentType, body)
+			if err != nil {
+				t.Fatalf("do request: %v", err)
+			}
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.statusCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.statusCode, resp.StatusCode, respBody)
+			}
+			if got := resp.Header.Get(retryAfterHeader); got != tt.retryAfter {
+				t.Fatalf("expected Retry-After %q, got %q", tt.retryAfter, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+var _ Authenticator = (*APIKeyAuthenticator)(nil)
+
+// APIKeyAuthenticator authenticates clients by static keys.
+type APIKeyAuthenticator struct {
+	// principals by SHA-256 of key, so time of lookup does not depend on how much of key is guessed.
+	principals map[[sha256.Size]byte]Principal
+}
+
+// NewAPIKeyAuthenticator makes authenticator from principals by keys.
+func NewAPIKeyAuthenticator(keys map[string]Principal) (*APIKeyAuthenticator, error) {
+	a := APIKeyAuthenticator{
+		principals: make(map[[sha256.Size]byte]Principal, len(keys)),
+	}
+
+	for key, principal := range keys {
+		if key == "" {
+			return nil, fmt.Errorf("empty api key of principal %s", principal.ID)
+		}
+		if err := ValidatePrincipalID(principal.ID); err != nil {
+			return nil, err
+		}
+		a.principals[sha256.Sum256([]byte(key))] = principal
+	}
+
+	return &a, nil
+}
+
+// ParseAPIKeys parses list of keys like "key1:alice,key2:bob:admin|ops", roles of principal are optional.
+func ParseAPIKeys(s string) (map[string]Principal, error) {
+	keys := make(map[string]Principal)
+	if s == "" {
+		return keys, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		key, id, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid api key %q, expected key:principal[:roles]", pair)
+		}
+
+		principal := Principal{ID: id}
+		if id, roles, ok := strings.Cut(id, ":"); ok {
+			principal = Principal{ID: id, Roles: strings.Split(roles, "|")}
+		}
+		keys[key] = principal
+	}
+
+	return keys, nil
+}
+
+func (a *APIKeyAuthenticator) Authenticate(_ context.Context, credentials string) (*Principal, error) {
+	principal, ok := a.principals[sha256.Sum256([]byte(credentials))]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown api key", ErrUnauthenticated)
+	}
+
+	return &principal, nil
+}
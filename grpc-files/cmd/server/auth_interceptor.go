@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const authorizationHeader = "authorization"
+
+// healthServicePrefix is prefix of methods of health service, which are called by probes without credentials.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// Schemes of authorization header.
+const (
+	apiKeyAuthScheme    = "ApiKey"
+	bearerAuthScheme    = "Bearer"
+	signedURLAuthScheme = "SignedURL"
+)
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, credentials string) (*Principal, error)
+}
+
+// AuthInterceptor authenticates every call by authorization header "<scheme> <credentials>",
+// or by TLS certificate of client if call has no authorization header, and puts principal to context of call.
+type AuthInterceptor struct {
+	authenticators map[string]Authenticator
+	// clientCertAuthenticator is nil if clients are not authenticated by certificates.
+	clientCertAuthenticator *ClientCertAuthenticator
+}
+
+// NewAuthInterceptor makes interceptor with authenticators by schemes, scheme is case insensitive.
+func NewAuthInterceptor(authenticators map[string]Authenticator, clientCertAuthenticator *ClientCertAuthenticator) *AuthInterceptor {
+	byScheme := make(map[string]Authenticator, len(authenticators))
+	for scheme, a := range authenticators {
+		byScheme[strings.ToLower(scheme)] = a
+	}
+
+	return &AuthInterceptor{
+		authenticators:          byScheme,
+		clientCertAuthenticator: clientCertAuthenticator,
+	}
+}
+
+func (i *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(ctx, req)
+	}
+
+	ctx, err := i.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *AuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(srv, ss)
+	}
+
+	ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationHeader)
+
+	var (
+		authenticator Authenticator
+		credentials   string
+	)
+	switch {
+	case len(values) == 0 && i.clientCertAuthenticator != nil:
+		authenticator = i.clientCertAuthenticator
+	case len(values) != 1:
+		return nil, status.Error(codes.Unauthenticated, "authorization header is required")
+	default:
+		var scheme string
+		scheme, credentials, _ = strings.Cut(values[0], " ")
+
+		var ok bool
+		authenticator, ok = i.authenticators[strings.ToLower(scheme)]
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "unsupported authorization scheme %q", scheme)
+		}
+	}
+
+	principal, err := authenticator.Authenticate(ctx, strings.TrimSpace(credentials))
+	if errors.Is(err, ErrUnauthenticated) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("authenticate: %w", err)
+	}
+	if principal.Scope != nil && !principal.Scope.AllowsMethod(fullMethod) {
+		return nil, status.Errorf(codes.PermissionDenied, "credentials do not allow %s", fullMethod)
+	}
+
+	return ContextWithPrincipal(ctx, principal), nil
+}
+
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
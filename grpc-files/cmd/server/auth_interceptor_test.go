@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newAPIKeyTestServer makes server which authenticates principals by api keys "<id>-key".
+func newAPIKeyTestServer(t *testing.T, principals ...Principal) *testServer {
+	t.Helper()
+
+	keys := make(map[string]Principal, len(principals))
+	for _, principal := range principals {
+		keys[principal.ID+"-key"] = principal
+	}
+	authenticator, err := NewAPIKeyAuthenticator(keys)
+	if err != nil {
+		t.Fatalf("new api key authenticator: %v", err)
+	}
+
+	return newAuthTestServer(t, StorageLimits{}, NewAuthInterceptor(map[string]Authenticator{apiKeyAuthScheme: authenticator}, nil))
+}
+
+// apiKeyContext returns context of calls of principal with key of newAPIKeyTestServer.
+func apiKeyContext(id string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, apiKeyAuthScheme+" "+id+"-key")
+}
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	apiKeyAuthenticator, err := NewAPIKeyAuthenticator(map[string]Principal{"alice-key": {ID: "alice", Roles: []string{"ops"}}})
+	if err != nil {
+		t.Fatalf("new api key authenticator: %v", err)
+	}
+	jwtAuthenticator, err := NewJWTAuthenticator(JWTConfig{Secret: testJWTSecret})
+	if err != nil {
+		t.Fatalf("new jwt authenticator: %v", err)
+	}
+	interceptor := NewAuthInterceptor(map[string]Authenticator{
+		apiKeyAuthScheme: apiKeyAuthenticator,
+		bearerAuthScheme: jwtAuthenticator,
+	}, nil)
+
+	bobToken := signTestJWT(t, testJWTSecret, `{"alg":"HS256","typ":"JWT"}`, fmt.Sprintf(`{"sub":"bob","exp":%d}`, time.Now().Add(time.Hour).Unix()))
+	const filesMethod = "/example.files.v1.FilesService/GetFileHeader"
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization []string
+		code          codes.Code
+		principal     string
+	}{
+		{name: "api key", authorization: []string{"ApiKey alice-key"}, principal: "alice"},
+		{name: "scheme in other case", authorization: []string{"apikey alice-key"}, principal: "alice"},
+		{name: "jwt", authorization: []string{"Bearer " + bobToken}, principal: "bob"},
+		{name: "unknown api key", authorization: []string{"ApiKey bob-key"}, code: codes.Unauthenticated},
+		{name: "api key as jwt", authorization: []string{"Bearer alice-key"}, code: codes.Unauthenticated},
+		{name: "unsupported scheme", authorization: []string{"Basic YWxpY2U6a2V5"}, code: codes.Unauthenticated},
+		{name: "without credentials", code: codes.Unauthenticated},
+		{name: "several authorization headers", authorization: []string{"ApiKey alice-key", "ApiKey alice-key"}, code: codes.Unauthenticated},
+		{name: "health check without credentials", method: healthServicePrefix + "Check"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = filesMethod
+			}
+
+			ctx := context.Background()
+			if tt.authorization != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{authorizationHeader: tt.authorization})
+			}
+
+			var principal *Principal
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				principal = PrincipalFromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected code %s, got %v", tt.code, err)
+			}
+			if tt.principal == "" {
+				if principal != nil {
+					t.Fatalf("unexpected principal %+v", principal)
+				}
+				return
+			}
+			if principal == nil || principal.ID != tt.principal {
+				t.Fatalf("expected principal %s, got %+v", tt.principal, principal)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_Server(t *testing.T) {
+	server := newAPIKeyTestServer(t, Principal{ID: "alice"})
+
+	unauthenticated := map[string]context.Context{
+		"without credentials": context.Background(),
+		"unknown api key":     metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, "ApiKey bob-key"),
+	}
+	for name, ctx := range unauthenticated {
+		t.Run(name, func(t *testing.T) {
+			if _, err := server.client.ListFilesHeader(ctx, &files.ListFilesHeaderRequest{}); status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("expected Unauthenticated of unary call, got %v", err)
+			}
+			if _, err := server.upload(ctx, &files.UploadFileRequest_Info{Name: "a.txt"}, "content", 7); status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("expected Unauthenticated of stream call, got %v", err)
+			}
+		})
+	}
+	if infos, err := server.filesSystem.ListFilesInfo(context.Background(), ""); err != nil || len(infos) != 0 {
+		t.Fatalf("expected no files after rejected calls, got %v, error %v", infos, err)
+	}
+
+	ctx := apiKeyContext("alice")
+	resp, err := server.upload(ctx, &files.UploadFileRequest_Info{Name: "folder/a.txt"}, "content", 7)
+	if err != nil {
+		t.Fatalf("upload file: %v", err)
+	}
+	if name := resp.GetFileHeader().GetName(); name != "folder/a.txt" {
+		t.Fatalf("expected name relative to folder of principal, got %s", name)
+	}
+	if _, err = server.filesSystem.StatFile(context.Background(), "alice/folder/a.txt"); err != nil {
+		t.Fatalf("expected file in folder of principal: %v", err)
+	}
+
+	list, err := server.client.ListFilesHeader(ctx, &files.ListFilesHeaderRequest{Parent: "folder"})
+	if err != nil {
+		t.Fatalf("list files: %v", err)
+	}
+	var names []string
+	for _, item := range list.GetItems() {
+		names = append(names, item.GetName())
+	}
+	if got := strings.Join(names, ","); got != "folder/a.txt" {
+		t.Fatalf("unexpected listed files %s", got)
+	}
+}
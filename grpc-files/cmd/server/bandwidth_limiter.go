@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/time/rate"
+)
+
+// BandwidthLimiter caps bytes per second of one stream, nil limiter is unlimited.
+type BandwidthLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewBandwidthLimiter returns nil limiter if bytes per second is zero.
+func NewBandwidthLimiter(bytesPerSecond int) *BandwidthLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &BandwidthLimiter{limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)}
+}
+
+// Wait blocks until n bytes can be sent or received, chunks larger than one second of bandwidth are waited by parts.
+func (l *BandwidthLimiter) Wait(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+
+	for n > 0 {
+		part := n
+		if burst := l.limiter.Burst(); part > burst {
+			part = burst
+		}
+
+		if err := l.limiter.WaitN(ctx, part); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Wait is refused in advance if deadline of call is earlier than bytes are allowed.
+			return fmt.Errorf("%w: %s", context.DeadlineExceeded, err)
+		}
+		n -= part
+	}
+
+	return nil
+}
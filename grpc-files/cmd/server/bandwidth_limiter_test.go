@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBandwidthLimiter_Unlimited(t *testing.T) {
+	limiter := NewBandwidthLimiter(0)
+	if limiter != nil {
+		t.Fatalf("expected nil limiter of zero bandwidth")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx, 1<<30); err != nil {
+		t.Fatalf("unexpected error of nil limiter %v", err)
+	}
+}
+
+func TestBandwidthLimiter_Wait(t *testing.T) {
+	limiter := NewBandwidthLimiter(10000)
+	ctx := context.Background()
+
+	start := time.Now()
+	if err := limiter.Wait(ctx, 10000); err != nil {
+		t.Fatalf("wait burst: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("expected burst without wait, waited %s", elapsed)
+	}
+
+	// Bytes over burst are waited for.
+	start = time.Now()
+	if err := limiter.Wait(ctx, 2000); err != nil {
+		t.Fatalf("wait chunk: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected wait of 200ms, waited %s", elapsed)
+	}
+
+	// Chunk larger than burst is waited by parts.
+	if err := NewBandwidthLimiter(10000).Wait(ctx, 12000); err != nil {
+		t.Fatalf("wait chunk larger than burst: %v", err)
+	}
+}
+
+func TestBandwidthLimiter_WaitContext(t *testing.T) {
+	limiter := NewBandwidthLimiter(1000)
+	if err := limiter.Wait(context.Background(), 1000); err != nil {
+		t.Fatalf("wait burst: %v", err)
+	}
+
+	// Deadline before bytes are allowed is refused at once.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, 1000); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx, 1000); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+)
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// FileChecksums are expected digests of file content, empty values are not checked.
+type FileChecksums struct {
+	SHA256 string  `json:"sha256,omitempty"`
+	CRC32C *uint32 `json:"crc32c,omitempty"`
+}
+
+func (c FileChecksums) Empty() bool {
+	return c.SHA256 == "" && c.CRC32C == nil
+}
+
+func (c FileChecksums) Equal(other FileChecksums) bool {
+	if !strings.EqualFold(c.SHA256, other.SHA256) {
+		return false
+	}
+	if c.CRC32C == nil || other.CRC32C == nil {
+		return c.CRC32C == other.CRC32C
+	}
+	return *c.CRC32C == *other.CRC32C
+}
+
+// ChecksumReader computes digests of read content and checks them with expected checksums at end of content,
+// so consumer of reader gets ErrChecksumMismatch instead of EOF and does not commit corrupted content.
+type ChecksumReader struct {
+	content  io.Reader
+	expected FileChecksums
+	sha256   hash.Hash
+	crc32c   hash.Hash32
+}
+
+func NewChecksumReader(content io.Reader, expected FileChecksums) *ChecksumReader {
+	return &ChecksumReader{
+		content:  content,
+		expected: expected,
+		sha256:   sha256.New(),
+		crc32c:   crc32.New(crc32cTable),
+	}
+}
+
+func (r *ChecksumReader) Read(dst []byte) (int, error) {
+	n, err := r.content.Read(dst)
+	r.sha256.Write(dst[:n])
+	r.crc32c.Write(dst[:n])
+
+	if errors.Is(err, io.EOF) {
+		if verifyErr := r.verify(); verifyErr != nil {
+			return n, verifyErr
+		}
+	}
+
+	return n, err
+}
+
+func (r *ChecksumReader) verify() error {
+	if r.expected.SHA256 != "" && !strings.EqualFold(r.expected.SHA256, r.SHA256()) {
+		return fmt.Errorf("%w: sha256 of content is %s, expected %s", ErrChecksumMismatch, r.SHA256(), r.expected.SHA256)
+	}
+	if r.expected.CRC32C != nil && *r.expected.CRC32C != r.CRC32C() {
+		return fmt.Errorf("%w: crc32c of content is %d, expected %d", ErrChecksumMismatch, r.CRC32C(), *r.expected.CRC32C)
+	}
+
+	return nil
+}
+
+// SHA256 returns hex encoded SHA-256 of content read so far.
+func (r *ChecksumReader) SHA256() string {
+	return hex.EncodeToString(r.sha256.Sum(nil))
+}
+
+// CRC32C returns CRC32C of content read so far.
+func (r *ChecksumReader) CRC32C() uint32 {
+	return r.crc32c.Sum32()
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestChecksumReader(t *testing.T) {
+	const content = "hello world"
+
+	// Digests of content.
+	const sha256OfContent = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	crc32cOfContent := uint32(0xc99465aa)
+	otherCRC32C := crc32cOfContent + 1
+
+	tests := []struct {
+		name     string
+		expected FileChecksums
+		mismatch bool
+	}{
+		{name: "no checksums"},
+		{name: "sha256", expected: FileChecksums{SHA256: sha256OfContent}},
+		{name: "sha256 in upper case", expected: FileChecksums{SHA256: strings.ToUpper(sha256OfContent)}},
+		{name: "crc32c", expected: FileChecksums{CRC32C: &crc32cOfContent}},
+		{name: "both", expected: FileChecksums{SHA256: sha256OfContent, CRC32C: &crc32cOfContent}},
+		{name: "sha256 mismatch", expected: FileChecksums{SHA256: strings.Repeat("0", 64)}, mismatch: true},
+		{name: "crc32c mismatch", expected: FileChecksums{CRC32C: &otherCRC32C}, mismatch: true},
+		{name: "crc32c mismatch with sha256", expected: FileChecksums{SHA256: sha256OfContent, CRC32C: &otherCRC32C}, mismatch: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Content is read by one byte, so digests are computed over many reads.
+			reader := NewChecksumReader(iotest.OneByteReader(strings.NewReader(content)), tt.expected)
+
+			var dst bytes.Buffer
+			_, err := io.Copy(&dst, reader)
+
+			if tt.mismatch {
+				if !errors.Is(err, ErrChecksumMismatch) {
+					t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("read content: %v", err)
+			}
+			if dst.String() != content {
+				t.Fatalf("expected content %q, got %q", content, dst.String())
+			}
+			if reader.SHA256() != sha256OfContent || reader.CRC32C() != crc32cOfContent {
+				t.Fatalf("unexpected digests %s and %x", reader.SHA256(), reader.CRC32C())
+			}
+		})
+	}
+}
+
+func TestChecksumReader_ReadError(t *testing.T) {
+	readErr := errors.New("read error")
+	sha256 := strings.Repeat("0", 64)
+
+	// Error of content is returned as is and checksums are not checked before end of content.
+	reader := NewChecksumReader(iotest.ErrReader(readErr), FileChecksums{SHA256: sha256})
+	if _, err := io.ReadAll(reader); !errors.Is(err, readErr) {
+		t.Fatalf("expected read error, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+var _ Authenticator = (*ClientCertAuthenticator)(nil)
+
+// ClientCertAuthenticator authenticates calls without authorization header by verified TLS certificate of client,
+// common name of subject is id of principal and organizational units are its roles.
+// Certificates of proxies, like gateway, only protect connection, their calls must have authorization header
+// of client on whose behalf proxy calls.
+type ClientCertAuthenticator struct {
+	proxies map[string]struct{}
+}
+
+func NewClientCertAuthenticator(proxies []string) *ClientCertAuthenticator {
+	a := ClientCertAuthenticator{
+		proxies: make(map[string]struct{}, len(proxies)),
+	}
+	for _, proxy := range proxies {
+		a.proxies[proxy] = struct{}{}
+	}
+
+	return &a
+}
+
+// Authenticate ignores credentials, certificate is got from peer of call.
+func (a *ClientCertAuthenticator) Authenticate(ctx context.Context, _ string) (*Principal, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: authorization header is required", ErrUnauthenticated)
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, fmt.Errorf("%w: authorization header or client certificate is required", ErrUnauthenticated)
+	}
+
+	subject := tlsInfo.State.VerifiedChains[0][0].Subject
+	if _, ok = a.proxies[subject.CommonName]; ok {
+		return nil, fmt.Errorf("%w: authorization header is required for call over proxy %s", ErrUnauthenticated, subject.CommonName)
+	}
+	if err := ValidatePrincipalID(subject.CommonName); err != nil {
+		return nil, fmt.Errorf("%w: invalid common name of client certificate: %s", ErrUnauthenticated, err)
+	}
+
+	return &Principal{
+		ID:    subject.CommonName,
+		Roles: subject.OrganizationalUnit,
+	}, nil
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/tracing"
+)
+
+const (
+	localFilesSystem            = "local"
+	contentAddressedFilesSystem = "content-addressed"
+	s3FilesSystem               = "s3"
+	memoryFilesSystem           = "memory"
+)
+
+// Config of server is loaded from YAML file, environment variables and flags, see package config.
+type Config struct {
+	Address string `yaml:"address" env:"SERVER_ADDRESS" flag:"address" usage:"address of grpc server"`
+	// ShutdownTimeout is time of waiting for running calls on shutdown, then they are cancelled.
+	ShutdownTimeout time.Duration   `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" usage:"time of waiting for running calls on shutdown"`
+	Health          HealthConfig    `yaml:"health"`
+	Storage         StorageConfig   `yaml:"storage"`
+	Limits          LimitsConfig    `yaml:"limits"`
+	RateLimit       RateLimitConfig `yaml:"rate_limit"`
+	Upload          UploadConfig    `yaml:"upload"`
+	Download        DownloadConfig  `yaml:"download"`
+	Auth            AuthConfig      `yaml:"auth"`
+	TLS             TLSConfig       `yaml:"tls"`
+	SignedURL       SignedURLConfig `yaml:"signed_url"`
+	Metrics         MetricsConfig   `yaml:"metrics"`
+	Tracing         tracing.Config  `yaml:"tracing"`
+	Log             logging.Config  `yaml:"log"`
+}
+
+type StorageConfig struct {
+	Backend          string                        `yaml:"backend" env:"FILES_SYSTEM" flag:"storage" usage:"files system: local, content-addressed, s3 or memory"`
+	Local            LocalStorageConfig            `yaml:"local"`
+	ContentAddressed ContentAddressedStorageConfig `yaml:"content_addressed"`
+	S3               S3StorageConfig               `yaml:"s3"`
+	Memory           MemoryStorageConfig           `yaml:"memory"`
+	// MetadataFile is in state dir of files system if it is empty.
+	MetadataFile string `yaml:"metadata_file" env:"FILES_METADATA_FILE"`
+	// ContentTypesByExtension is ".ext=type" pairs separated by comma.
+	ContentTypesByExtension string `yaml:"content_types_by_extension" env:"CONTENT_TYPES_BY_EXTENSION"`
+}
+
+type LocalStorageConfig struct {
+	// Root is home dir of user if it is empty.
+	Root string `yaml:"root" env:"LOCAL_FILE_SYSTEM_ROOT"`
+}
+
+type ContentAddressedStorageConfig struct {
+	Root                 string        `yaml:"root" env:"CONTENT_ADDRESSED_FILE_SYSTEM_ROOT"`
+	GarbageCollectPeriod time.Duration `yaml:"garbage_collect_period" env:"CONTENT_ADDRESSED_GARBAGE_COLLECT_PERIOD"`
+}
+
+type S3StorageConfig struct {
+	Endpoint        string `yaml:"endpoint" env:"S3_ENDPOINT"`
+	AccessKeyID     string `yaml:"access_key_id" env:"S3_ACCESS_KEY_ID"`
+	SecretAccessKey string `yaml:"secret_access_key" env:"S3_SECRET_ACCESS_KEY" secret:"true"`
+	Region          string `yaml:"region" env:"S3_REGION"`
+	Bucket          string `yaml:"bucket" env:"S3_BUCKET"`
+	Prefix          string `yaml:"prefix" env:"S3_PREFIX"`
+	UseSSL          bool   `yaml:"use_ssl" env:"S3_USE_SSL"`
+	PartSize        uint64 `yaml:"part_size" env:"S3_PART_SIZE"`
+	// StateDir is working dir if it is empty.
+	StateDir string `yaml:"state_dir" env:"S3_STATE_DIR"`
+}
+
+type MemoryStorageConfig struct {
+	MaxSize       uint64        `yaml:"max_size" env:"MEMORY_FILE_SYSTEM_MAX_SIZE"`
+	MaxFiles      int           `yaml:"max_files" env:"MEMORY_FILE_SYSTEM_MAX_FILES"`
+	FailSaveAfter uint64        `yaml:"fail_save_after" env:"MEMORY_FILE_SYSTEM_FAIL_SAVE_AFTER"`
+	ReadDelay     time.Duration `yaml:"read_delay" env:"MEMORY_FILE_SYSTEM_READ_DELAY"`
+}
+
+// LimitsConfig is limits in bytes and counts of files, zero limit is unlimited.
+type LimitsConfig struct {
+	MaxFileSize          uint64 `yaml:"max_file_size" env:"STORAGE_MAX_FILE_SIZE"`
+	MaxBytes             uint64 `yaml:"max_bytes" env:"STORAGE_MAX_BYTES"`
+	MaxFiles             uint64 `yaml:"max_files" env:"STORAGE_MAX_FILES"`
+	MaxBytesPerPrincipal uint64 `yaml:"max_bytes_per_principal" env:"STORAGE_MAX_BYTES_PER_PRINCIPAL"`
+	MaxFilesPerPrincipal uint64 `yaml:"max_files_per_principal" env:"STORAGE_MAX_FILES_PER_PRINCIPAL"`
+}
+
+// RateLimitConfig limits calls per second by addresses of clients and by principals, zero rate is unlimited.
+// Burst is count of calls, which can be made at once, it is rate rounded up if it is zero.
+type RateLimitConfig struct {
+	AddressRate    float64 `yaml:"address_rate" env:"RATE_LIMIT_ADDRESS_RATE"`
+	AddressBurst   int     `yaml:"address_burst" env:"RATE_LIMIT_ADDRESS_BURST"`
+	PrincipalRate  float64 `yaml:"principal_rate" env:"RATE_LIMIT_PRINCIPAL_RATE"`
+	PrincipalBurst int     `yaml:"principal_burst" env:"RATE_LIMIT_PRINCIPAL_BURST"`
+	// TrustedProxies are IPs or CIDRs of proxies like gateway, address of client is taken from x-forwarded-for
+	// of their calls.
+	TrustedProxies []string `yaml:"trusted_proxies" env:"RATE_LIMIT_TRUSTED_PROXIES"`
+	// StreamBytesPerSecond caps bandwidth of every upload and download stream, zero is unlimited.
+	StreamBytesPerSecond int `yaml:"stream_bytes_per_second" env:"RATE_LIMIT_STREAM_BYTES_PER_SECOND"`
+}
+
+type UploadConfig struct {
+	// StagingDir is in state dir of files system if it is empty.
+	StagingDir string `yaml:"staging_dir" env:"UPLOAD_STAGING_DIR"`
+	// SessionTTL is time after last activity of not completed upload, when it is deleted.
+	SessionTTL  time.Duration `yaml:"session_ttl" env:"UPLOAD_SESSION_TTL"`
+	CleanPeriod time.Duration `yaml:"clean_period" env:"UPLOAD_STAGING_CLEAN_PERIOD"`
+	BufferSize  int           `yaml:"buffer_size" env:"UPLOAD_BUFFER_SIZE"`
+}
+
+type DownloadConfig struct {
+	ChunkSize int `yaml:"chunk_size" env:"DOWNLOAD_CHUNK_SIZE"`
+}
+
+// AuthConfig enables authentication if API keys, JWT secret or CAs of client certificates are set.
+type AuthConfig struct {
+	// APIKeys is list like "key1:alice,key2:bob:admin|ops", see ParseAPIKeys.
+	APIKeys           string        `yaml:"api_keys" env:"AUTH_API_KEYS" secret:"true"`
+	JWT               JWTAuthConfig `yaml:"jwt"`
+	ClientCertProxies []string      `yaml:"client_cert_proxies" env:"AUTH_CLIENT_CERT_PROXIES"`
+}
+
+type JWTAuthConfig struct {
+	Secret   string `yaml:"secret" env:"AUTH_JWT_SECRET" secret:"true"`
+	Issuer   string `yaml:"issuer" env:"AUTH_JWT_ISSUER"`
+	Audience string `yaml:"audience" env:"AUTH_JWT_AUDIENCE"`
+	// AllowNoExpiry accepts tokens without "exp" claim, they are valid forever.
+	AllowNoExpiry bool `yaml:"allow_no_expiry" env:"AUTH_JWT_ALLOW_NO_EXPIRY"`
+}
+
+// TLSConfig disables TLS if certificate is not set.
+type TLSConfig struct {
+	CertFile          string `yaml:"cert_file" env:"TLS_CERT_FILE" flag:"tls-cert" usage:"PEM file of server certificate, TLS is disabled if it is empty"`
+	KeyFile           string `yaml:"key_file" env:"TLS_KEY_FILE" flag:"tls-key" usage:"PEM file of server private key"`
+	ClientCAFile      string `yaml:"client_ca_file" env:"TLS_CLIENT_CA_FILE" flag:"tls-client-ca" usage:"PEM file of CAs of client certificates, clients with verified certificates are authenticated by them"`
+	RequireClientCert bool   `yaml:"require_client_cert" env:"TLS_REQUIRE_CLIENT_CERT" flag:"tls-require-client-cert" usage:"reject clients without certificate"`
+}
+
+// HealthConfig is checking of storage backend, which is reported by grpc health service.
+type HealthConfig struct {
+	CheckPeriod  time.Duration `yaml:"check_period" env:"HEALTH_CHECK_PERIOD"`
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+}
+
+// MetricsConfig disables metrics endpoint if address is empty.
+type MetricsConfig struct {
+	Address string `yaml:"address" env:"METRICS_ADDRESS" flag:"metrics-address" usage:"address of http server of /metrics, it is disabled if it is empty"`
+}
+
+// SignedURLConfig disables signed urls if secret is not set.
+type SignedURLConfig struct {
+	Secret string `yaml:"secret" env:"SIGNED_URL_SECRET" secret:"true"`
+	Base   string `yaml:"base" env:"SIGNED_URL_BASE"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Address:         "0.0.0.0:9000",
+		ShutdownTimeout: time.Second * 30,
+		Health: HealthConfig{
+			CheckPeriod:  time.Second * 10,
+			CheckTimeout: time.Second * 5,
+		},
+		Storage: StorageConfig{
+			Backend: localFilesSystem,
+			ContentAddressed: ContentAddressedStorageConfig{
+				GarbageCollectPeriod: time.Hour,
+			},
+		},
+		Upload: UploadConfig{
+			SessionTTL:  time.Hour * 24,
+			CleanPeriod: time.Hour,
+			BufferSize:  1024,
+		},
+		Download: DownloadConfig{
+			ChunkSize: 1024,
+		},
+		SignedURL: SignedURLConfig{
+			Base: "http://localhost:8080",
+		},
+		Metrics: MetricsConfig{
+			Address: "0.0.0.0:9090",
+		},
+		Tracing: tracing.DefaultConfig(),
+		Log:     logging.DefaultConfig(),
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.Address == "" {
+		return errors.New("address is required")
+	}
+
+	if c.ShutdownTimeout < 0 {
+		return errors.New("shutdown timeout must not be negative")
+	}
+	if c.Health.CheckPeriod <= 0 || c.Health.CheckTimeout <= 0 {
+		return errors.New("period and timeout of health checks must be positive")
+	}
+
+	switch c.Storage.Backend {
+	case localFilesSystem, contentAddressedFilesSystem, memoryFilesSystem:
+	case s3FilesSystem:
+		if c.Storage.S3.Bucket == "" {
+			return errors.New("bucket of s3 storage is required")
+		}
+	default:
+		return fmt.Errorf("unknown files system %q", c.Storage.Backend)
+	}
+
+	if c.Storage.ContentAddressed.GarbageCollectPeriod <= 0 {
+		return errors.New("garbage collect period must be positive")
+	}
+	if _, err := ParseContentTypesByExtension(c.Storage.ContentTypesByExtension); err != nil {
+		return fmt.Errorf("parse content types by extension: %w", err)
+	}
+
+	if c.Upload.SessionTTL <= 0 || c.Upload.CleanPeriod <= 0 {
+		return errors.New("session ttl and clean period of uploads must be positive")
+	}
+	if c.Upload.BufferSize <= 0 || c.Download.ChunkSize <= 0 {
+		return errors.New("upload buffer size and download chunk size must be positive")
+	}
+
+	if _, err := ParseAPIKeys(c.Auth.APIKeys); err != nil {
+		return fmt.Errorf("parse api keys: %w", err)
+	}
+
+	if err := c.Tracing.Validate(); err != nil {
+		return fmt.Errorf("invalid tracing config: %w", err)
+	}
+	if err := c.Log.Validate(); err != nil {
+		return fmt.Errorf("invalid log config: %w", err)
+	}
+
+	if c.RateLimit.AddressRate < 0 || c.RateLimit.PrincipalRate < 0 || c.RateLimit.StreamBytesPerSecond < 0 {
+		return errors.New("rate limits can not be negative")
+	}
+	if _, err := ParseTrustedProxies(c.RateLimit.TrustedProxies); err != nil {
+		return err
+	}
+
+	if c.TLS.CertFile == "" && (c.TLS.ClientCAFile != "" || c.TLS.RequireClientCert) {
+		return errors.New("tls certificate of server is required for client certificates")
+	}
+	if c.TLS.CertFile != "" && c.TLS.KeyFile == "" {
+		return errors.New("tls key of server is required")
+	}
+	if c.TLS.RequireClientCert && c.TLS.ClientCAFile == "" {
+		return errors.New("CAs of client certificates are required to require client certificate")
+	}
+
+	return nil
+}
+
+func (c *Config) StorageLimits() StorageLimits {
+	return StorageLimits(c.Limits)
+}
+
+func (c *Config) S3Config() S3Config {
+	return S3Config{
+		Endpoint:        c.Storage.S3.Endpoint,
+		AccessKeyID:     c.Storage.S3.AccessKeyID,
+		SecretAccessKey: c.Storage.S3.SecretAccessKey,
+		Region:          c.Storage.S3.Region,
+		Bucket:          c.Storage.S3.Bucket,
+		Prefix:          c.Storage.S3.Prefix,
+		UseSSL:          c.Storage.S3.UseSSL,
+		PartSize:        c.Storage.S3.PartSize,
+	}
+}
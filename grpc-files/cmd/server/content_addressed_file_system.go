@@ -0,0 +1,599 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ FilesSystem = (*ContentAddressedFileSystem)(nil)
+
+// ContentAddressedFileSystem stores content of files in blobs named by SHA-256 of content, so equal files
+// are stored once. Files are small references to blobs in folders tree, which is same as tree of LocalFileSystem.
+// Blobs without references are removed by CollectGarbage.
+type ContentAddressedFileSystem struct {
+	root     string
+	refsDir  string
+	blobsDir string
+	tempDir  string
+	// createdAt is time of start of file system, temp files older than it are left by broken saves.
+	createdAt time.Time
+
+	// gcMu is held for read while blob is touched and reference to it is saved, and for write while
+	// garbage collection removes blob, so blob is not removed between touch and save of reference.
+	gcMu sync.RWMutex
+}
+
+type blobRef struct {
+	SHA256 string `json:"sha256"`
+	Size   uint64 `json:"size"`
+}
+
+func MustNewContentAddressedFileSystem(root string) *ContentAddressedFileSystem {
+	cas, err := NewContentAddressedFileSystem(root)
+	if err != nil {
+		panic(fmt.Errorf("fatal init content addressed file system: %w", err))
+	}
+	return cas
+}
+
+func NewContentAddressedFileSystem(root string) (_ *ContentAddressedFileSystem, err error) {
+	if root == "" {
+		return nil, errors.New("empty root")
+	}
+
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("get absolute path of root: %w", err)
+	}
+
+	cas := ContentAddressedFileSystem{
+		root:     root,
+		refsDir:  filepath.Join(root, "refs"),
+		blobsDir: filepath.Join(root, "blobs"),
+		tempDir:  filepath.Join(root, "tmp"),
+		// Time of file system is rounded, so it is not after modification time of temp file of first save.
+		createdAt: time.Now().Truncate(time.Second),
+	}
+
+	for _, dir := range []string{cas.refsDir, cas.blobsDir, cas.tempDir} {
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create dir %s: %w", dir, err)
+		}
+	}
+
+	return &cas, nil
+}
+
+func (s *ContentAddressedFileSystem) Root() string {
+	return s.root
+}
+
+func (s *ContentAddressedFileSystem) ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error) {
+	dir, err := pathInRoot(s.refsDir, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, errNotDirectory) {
+		return nil, ErrFolderNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read refs dir: %w", err)
+	}
+
+	filesInfo := make([]FileInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		// Hidden files are temp files of references.
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		osInfo, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get info of %s: %w", entry.Name(), err)
+		}
+
+		info := FileInfo{
+			Name:       path.Join(parent, entry.Name()),
+			ModifiedAt: osInfo.ModTime().UTC(),
+			IsFolder:   entry.IsDir(),
+		}
+		if !info.IsFolder {
+			ref, err := readBlobRef(filepath.Join(dir, entry.Name()))
+			if errors.Is(err, ErrFileNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("read reference %s: %w", entry.Name(), err)
+			}
+			info.Size = ref.Size
+		}
+
+		filesInfo = append(filesInfo, info)
+	}
+
+	return filesInfo, nil
+}
+
+func (s *ContentAddressedFileSystem) SaveFile(ctx context.Context, name string, content io.Reader) (uint64, error) {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return 0, err
+	}
+
+	// Content is written without lock, so long save does not stall garbage collection.
+	tempPath, ref, err := s.writeTempBlob(ctx, content)
+	if err != nil {
+		return 0, fmt.Errorf("write temp blob: %w", err)
+	}
+	// Temp file is already moved to blobs if save is succeeded.
+	defer os.Remove(tempPath)
+
+	if err = os.MkdirAll(filepath.Dir(refPath), 0o755); err != nil {
+		return 0, fmt.Errorf("create parent folders: %w", err)
+	}
+
+	s.gcMu.RLock()
+	defer s.gcMu.RUnlock()
+
+	if err = s.publishBlob(tempPath, ref); err != nil {
+		return 0, fmt.Errorf("publish blob: %w", err)
+	}
+
+	if err = writeBlobRef(refPath, ref); err != nil {
+		return 0, fmt.Errorf("write reference: %w", err)
+	}
+
+	return ref.Size, nil
+}
+
+// writeTempBlob writes content to temp file and hashes it.
+func (s *ContentAddressedFileSystem) writeTempBlob(ctx context.Context, content io.Reader) (tempPath string, ref blobRef, err error) {
+	tempFile, err := os.CreateTemp(s.tempDir, "*.blob")
+	if err != nil {
+		return "", blobRef{}, fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tempFile.Close()
+			os.Remove(tempFile.Name())
+		}
+	}()
+
+	hash := sha256.New()
+
+	written, err := io.Copy(tempFile, io.TeeReader(NewContextReader(ctx, content), hash))
+	if err != nil {
+		return "", blobRef{}, fmt.Errorf("copy content to temp file: %w", err)
+	}
+
+	if err = tempFile.Sync(); err != nil {
+		return "", blobRef{}, fmt.Errorf("sync temp file: %w", err)
+	}
+
+	if err = tempFile.Close(); err != nil {
+		return "", blobRef{}, fmt.Errorf("close temp file: %w", err)
+	}
+
+	return tempFile.Name(), blobRef{
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+		Size:   uint64(written),
+	}, nil
+}
+
+// publishBlob moves temp file to blobs, if blob with same hash is already stored it is kept.
+// Blob is touched, so it is not removed by running garbage collection. It is called under read lock of gcMu.
+func (s *ContentAddressedFileSystem) publishBlob(tempPath string, ref blobRef) error {
+	blobPath := s.blobPath(ref.SHA256)
+
+	_, err := os.Stat(blobPath)
+	if errors.Is(err, os.ErrNotExist) {
+		if err = os.MkdirAll(filepath.Dir(blobPath), 0o755); err != nil {
+			return fmt.Errorf("create blob shard dir: %w", err)
+		}
+		if err = os.Rename(tempPath, blobPath); err != nil {
+			return fmt.Errorf("move temp file to blobs: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("check stored blob: %w", err)
+	}
+
+	return s.touchBlob(ref.SHA256)
+}
+
+// touchBlob marks blob as used now, garbage collection skips blobs used after its start,
+// since their references could be missed by walk of references.
+func (s *ContentAddressedFileSystem) touchBlob(hash string) error {
+	now := time.Now()
+	if err := os.Chtimes(s.blobPath(hash), now, now); err != nil {
+		return fmt.Errorf("touch blob: %w", err)
+	}
+	return nil
+}
+
+func (s *ContentAddressedFileSystem) StatFile(ctx context.Context, name string) (FileInfo, error) {
+	info, _, err := s.statRef(name)
+	return info, err
+}
+
+func (s *ContentAddressedFileSystem) ReadFile(ctx context.Context, name string) (info FileInfo, content io.ReadSeekCloser, err error) {
+	info, ref, err := s.statRef(name)
+	if errors.Is(err, ErrFileNotFound) {
+		return FileInfo{}, nil, nil
+	}
+	if err != nil {
+		return FileInfo{}, nil, err
+	}
+
+	f, err := os.Open(s.blobPath(ref.SHA256))
+	if err != nil {
+		return FileInfo{}, nil, fmt.Errorf("open blob: %w", err)
+	}
+
+	return info, f, nil
+}
+
+// statRef returns info of file from its reference, modification time is time of reference update.
+func (s *ContentAddressedFileSystem) statRef(name string) (FileInfo, *blobRef, error) {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return FileInfo{}, nil, err
+	}
+
+	osInfo, err := statFile(refPath)
+	if err != nil {
+		return FileInfo{}, nil, err
+	}
+
+	ref, err := readBlobRef(refPath)
+	if errors.Is(err, ErrFileNotFound) {
+		return FileInfo{}, nil, err
+	}
+	if err != nil {
+		return FileInfo{}, nil, fmt.Errorf("read reference: %w", err)
+	}
+
+	return FileInfo{
+		Name:       name,
+		Size:       ref.Size,
+		ModifiedAt: osInfo.ModTime().UTC(),
+	}, ref, nil
+}
+
+func (s *ContentAddressedFileSystem) DeleteFile(ctx context.Context, name string) error {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = statFile(refPath); err != nil {
+		return err
+	}
+
+	if err = os.Remove(refPath); err != nil {
+		return fmt.Errorf("remove reference: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ContentAddressedFileSystem) RenameFile(ctx context.Context, name, newName string) (uint64, error) {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return 0, err
+	}
+	newRefPath, err := pathInRoot(s.refsDir, newName)
+	if err != nil {
+		return 0, err
+	}
+
+	// Reference is moved under lock and its blob is touched, so garbage collection, which walks references
+	// at the same time, does not miss moved reference and does not remove its blob.
+	s.gcMu.RLock()
+	defer s.gcMu.RUnlock()
+
+	ref, err := readBlobRef(refPath)
+	if err != nil {
+		return 0, err
+	}
+	if err = s.touchBlob(ref.SHA256); err != nil {
+		return 0, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(newRefPath), 0o755); err != nil {
+		return 0, fmt.Errorf("create parent folders of new name: %w", err)
+	}
+
+	// Link fails if new name is already taken, unlike os.Rename which silently replaces it.
+	err = os.Link(refPath, newRefPath)
+	if errors.Is(err, os.ErrExist) {
+		return 0, ErrFileAlreadyExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("link reference with new name: %w", err)
+	}
+
+	if err = os.Remove(refPath); err != nil {
+		os.Remove(newRefPath)
+		return 0, fmt.Errorf("remove reference with old name: %w", err)
+	}
+
+	return ref.Size, nil
+}
+
+// CopyFile creates new reference to blob of file, content is not copied.
+func (s *ContentAddressedFileSystem) CopyFile(ctx context.Context, name, newName string) (size uint64, err error) {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return 0, err
+	}
+	newRefPath, err := pathInRoot(s.refsDir, newName)
+	if err != nil {
+		return 0, err
+	}
+
+	s.gcMu.RLock()
+	defer s.gcMu.RUnlock()
+
+	ref, err := readBlobRef(refPath)
+	if err != nil {
+		return 0, err
+	}
+	if err = s.touchBlob(ref.SHA256); err != nil {
+		return 0, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(newRefPath), 0o755); err != nil {
+		return 0, fmt.Errorf("create parent folders of new name: %w", err)
+	}
+
+	content, err := json.Marshal(ref)
+	if err != nil {
+		return 0, fmt.Errorf("marshal reference: %w", err)
+	}
+
+	dst, err := os.OpenFile(newRefPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		return 0, ErrFileAlreadyExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("create reference with new name: %w", err)
+	}
+	defer func() {
+		if closeErr := dst.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close reference with new name: %w", closeErr)
+		}
+		if err != nil {
+			os.Remove(newRefPath)
+		}
+	}()
+
+	if _, err = dst.Write(content); err != nil {
+		return 0, fmt.Errorf("write reference with new name: %w", err)
+	}
+
+	return ref.Size, nil
+}
+
+func (s *ContentAddressedFileSystem) CreateFolder(ctx context.Context, name string) error {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return err
+	}
+
+	return createFolder(refPath)
+}
+
+func (s *ContentAddressedFileSystem) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	refPath, err := pathInRoot(s.refsDir, name)
+	if err != nil {
+		return err
+	}
+	if refPath == s.refsDir {
+		return fmt.Errorf("%w: root folder can not be deleted", ErrInvalidArgument)
+	}
+
+	return deleteFolder(refPath, recursive)
+}
+
+// CollectGarbage removes blobs which are not referenced by any file and temp files of not completed saves.
+// References are walked without lock, so saves go on, and blobs used after start of collection are kept.
+func (s *ContentAddressedFileSystem) CollectGarbage(ctx context.Context) (deleted int, err error) {
+	// Start is taken under lock, so saves which touched blob before it have written their references
+	// and walk sees them. Start is rounded down, so blob touched after it is kept even if file system
+	// stores modification time with low precision.
+	s.gcMu.Lock()
+	start := time.Now().Truncate(time.Second)
+	s.gcMu.Unlock()
+
+	referenced := make(map[string]struct{})
+
+	err = filepath.WalkDir(s.refsDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+
+		// Reference deleted or renamed during walk is skipped, renamed one has touched its blob.
+		ref, err := readBlobRef(p)
+		if errors.Is(err, ErrFileNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read reference %s: %w", p, err)
+		}
+		referenced[ref.SHA256] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("collect referenced blobs: %w", err)
+	}
+
+	err = filepath.WalkDir(s.blobsDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		if _, ok := referenced[entry.Name()]; ok {
+			return nil
+		}
+
+		removed, err := s.removeUnusedBlob(p, start)
+		if err != nil {
+			return fmt.Errorf("remove blob %s: %w", entry.Name(), err)
+		}
+		if removed {
+			deleted++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return deleted, fmt.Errorf("remove not referenced blobs: %w", err)
+	}
+
+	// Saves of this process remove their temp files, so temp files older than process are left by broken saves.
+	tempEntries, err := os.ReadDir(s.tempDir)
+	if err != nil {
+		return deleted, fmt.Errorf("read temp dir: %w", err)
+	}
+	for _, entry := range tempEntries {
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return deleted, fmt.Errorf("get info of temp file %s: %w", entry.Name(), err)
+		}
+		if !info.ModTime().Before(s.createdAt) {
+			continue
+		}
+		if err = os.Remove(filepath.Join(s.tempDir, entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return deleted, fmt.Errorf("remove temp file %s: %w", entry.Name(), err)
+		}
+	}
+
+	return deleted, nil
+}
+
+// removeUnusedBlob removes blob, which is not referenced, unless it is used after start of garbage collection.
+// Lock is held only for one blob, so saves wait only for its removal.
+func (s *ContentAddressedFileSystem) removeUnusedBlob(blobPath string, start time.Time) (bool, error) {
+	s.gcMu.Lock()
+	defer s.gcMu.Unlock()
+
+	info, err := os.Stat(blobPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !info.ModTime().Before(start) {
+		return false, nil
+	}
+
+	if err = os.Remove(blobPath); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// blobPath shards blobs by first bytes of hash, so dirs do not grow too large.
+func (s *ContentAddressedFileSystem) blobPath(hash string) string {
+	return filepath.Join(s.blobsDir, hash[0:2], hash[2:4], hash)
+}
+
+func readBlobRef(refPath string) (*blobRef, error) {
+	if _, err := statFile(refPath); err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(refPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrFileNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read reference file: %w", err)
+	}
+
+	var ref blobRef
+	if err = json.Unmarshal(content, &ref); err != nil {
+		return nil, fmt.Errorf("unmarshal reference file: %w", err)
+	}
+	if _, err = hex.DecodeString(ref.SHA256); err != nil || len(ref.SHA256) != sha256.Size*2 {
+		return nil, fmt.Errorf("invalid hash %q in reference file", ref.SHA256)
+	}
+
+	return &ref, nil
+}
+
+// writeBlobRef replaces reference through temp file, so readers never see partial reference.
+func writeBlobRef(refPath string, ref blobRef) (err error) {
+	content, err := json.Marshal(ref)
+	if err != nil {
+		return fmt.Errorf("marshal reference: %w", err)
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(refPath), fmt.Sprintf(".%s.*.tmp", filepath.Base(refPath)))
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tempFile.Close()
+			os.Remove(tempFile.Name())
+		}
+	}()
+
+	if err = tempFile.Chmod(0o644); err != nil {
+		return fmt.Errorf("change file mode: %w", err)
+	}
+
+	if _, err = tempFile.Write(content); err != nil {
+		return fmt.Errorf("write temp file: %w", err)
+	}
+
+	if err = tempFile.Sync(); err != nil {
+		return fmt.Errorf("sync temp file: %w", err)
+	}
+
+	if err = tempFile.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+
+	if err = os.Rename(tempFile.Name(), refPath); err != nil {
+		return fmt.Errorf("change reference to temp file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countBlobs returns count of stored blobs.
+func countBlobs(t *testing.T, cas *ContentAddressedFileSystem) int {
+	t.Helper()
+
+	count := 0
+	err := filepath.WalkDir(cas.blobsDir, func(_ string, entry os.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			count++
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("walk blobs: %v", err)
+	}
+
+	return count
+}
+
+// ageBlob makes blob look used long ago, before start of garbage collection.
+func ageBlob(t *testing.T, cas *ContentAddressedFileSystem, hash string) {
+	t.Helper()
+
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(cas.blobPath(hash), past, past); err != nil {
+		t.Fatalf("age blob: %v", err)
+	}
+}
+
+func saveCASFile(t *testing.T, cas *ContentAddressedFileSystem, name, content string) string {
+	t.Helper()
+
+	if _, err := cas.SaveFile(context.Background(), name, strings.NewReader(content)); err != nil {
+		t.Fatalf("save file %s: %v", name, err)
+	}
+
+	refPath, err := pathInRoot(cas.refsDir, name)
+	if err != nil {
+		t.Fatalf("path of reference: %v", err)
+	}
+	ref, err := readBlobRef(refPath)
+	if err != nil {
+		t.Fatalf("read reference of %s: %v", name, err)
+	}
+
+	return ref.SHA256
+}
+
+func readCASFile(t *testing.T, cas *ContentAddressedFileSystem, name string) string {
+	t.Helper()
+
+	_, content, err := cas.ReadFile(context.Background(), name)
+	if err != nil || content == nil {
+		t.Fatalf("read file %s: %v", name, err)
+	}
+	defer content.Close()
+
+	b, err := io.ReadAll(content)
+	if err != nil {
+		t.Fatalf("read content of %s: %v", name, err)
+	}
+	return string(b)
+}
+
+func TestContentAddressedFileSystem_Deduplication(t *testing.T) {
+	ctx := context.Background()
+	cas := MustNewContentAddressedFileSystem(t.TempDir())
+
+	hash := saveCASFile(t, cas, "a.txt", "same content")
+	if otherHash := saveCASFile(t, cas, "folder/b.txt", "same content"); otherHash != hash {
+		t.Fatalf("equal content has hashes %s and %s", hash, otherHash)
+	}
+	if _, err := cas.CopyFile(ctx, "a.txt", "c.txt"); err != nil {
+		t.Fatalf("copy file: %v", err)
+	}
+	saveCASFile(t, cas, "d.txt", "other content")
+
+	if count := countBlobs(t, cas); count != 2 {
+		t.Fatalf("expected 2 blobs, got %d", count)
+	}
+	for _, name := range []string{"a.txt", "folder/b.txt", "c.txt"} {
+		if content := readCASFile(t, cas, name); content != "same content" {
+			t.Fatalf("unexpected content of %s: %q", name, content)
+		}
+	}
+
+	// Blob is kept while one of files refers to it.
+	for _, name := range []string{"a.txt", "folder/b.txt"} {
+		if err := cas.DeleteFile(ctx, name); err != nil {
+			t.Fatalf("delete file: %v", err)
+		}
+	}
+	ageBlob(t, cas, hash)
+
+	deleted, err := cas.CollectGarbage(ctx)
+	if err != nil || deleted != 0 {
+		t.Fatalf("expected no deleted blobs, got %d and error %v", deleted, err)
+	}
+	if content := readCASFile(t, cas, "c.txt"); content != "same content" {
+		t.Fatalf("unexpected content of copy: %q", content)
+	}
+}
+
+func TestContentAddressedFileSystem_CollectGarbageOfOrphanedBlobs(t *testing.T) {
+	ctx := context.Background()
+	cas := MustNewContentAddressedFileSystem(t.TempDir())
+
+	orphanHash := saveCASFile(t, cas, "orphan.txt", "orphan")
+	recentHash := saveCASFile(t, cas, "recent.txt", "recent")
+	keptHash := saveCASFile(t, cas, "kept.txt", "kept")
+
+	for _, name := range []string{"orphan.txt", "recent.txt"} {
+		if err := cas.DeleteFile(ctx, name); err != nil {
+			t.Fatalf("delete file: %v", err)
+		}
+	}
+	ageBlob(t, cas, orphanHash)
+	ageBlob(t, cas, keptHash)
+
+	// Temp file left by broken save before start of file system is removed, temp file of running save is kept.
+	brokenTemp := filepath.Join(cas.tempDir, "broken.blob")
+	runningTemp := filepath.Join(cas.tempDir, "running.blob")
+	for _, p := range []string{brokenTemp, runningTemp} {
+		if err := os.WriteFile(p, []byte("partial"), 0o644); err != nil {
+			t.Fatalf("write temp file: %v", err)
+		}
+	}
+	past := cas.createdAt.Add(-time.Hour)
+	if err := os.Chtimes(brokenTemp, past, past); err != nil {
+		t.Fatalf("age temp file: %v", err)
+	}
+
+	deleted, err := cas.CollectGarbage(ctx)
+	if err != nil {
+		t.Fatalf("collect garbage: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 deleted blob, got %d", deleted)
+	}
+
+	if _, err = os.Stat(cas.blobPath(orphanHash)); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected orphaned blob is removed, got %v", err)
+	}
+	// Orphaned blob used after start of collection is removed by next collection.
+	for _, p := range []string{cas.blobPath(recentHash), cas.blobPath(keptHash), runningTemp} {
+		if _, err = os.Stat(p); err != nil {
+			t.Fatalf("expected %s is kept, got %v", p, err)
+		}
+	}
+	if _, err = os.Stat(brokenTemp); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected broken temp file is removed, got %v", err)
+	}
+}
+
+func TestContentAddressedFileSystem_CollectGarbageDuringSave(t *testing.T) {
+	ctx := context.Background()
+	cas := MustNewContentAddressedFileSystem(t.TempDir())
+
+	hash := saveCASFile(t, cas, "a.txt", "content")
+	refPath, err := pathInRoot(cas.refsDir, "b.txt")
+	if err != nil {
+		t.Fatalf("path of reference: %v", err)
+	}
+
+	// Save of b.txt with same content has published blob, but has not written reference yet,
+	// and reference of a.txt is deleted meanwhile. Blob was touched before start of collection.
+	cas.gcMu.RLock()
+	if err = cas.DeleteFile(ctx, "a.txt"); err != nil {
+		t.Fatalf("delete file: %v", err)
+	}
+	ageBlob(t, cas, hash)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := cas.CollectGarbage(ctx)
+		done <- err
+	}()
+
+	select {
+	case err = <-done:
+		t.Fatalf("garbage collection is not blocked by save in progress: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	err = writeBlobRef(refPath, blobRef{SHA256: hash, Size: uint64(len("content"))})
+	cas.gcMu.RUnlock()
+	if err != nil {
+		t.Fatalf("write reference: %v", err)
+	}
+
+	if err = <-done; err != nil {
+		t.Fatalf("collect garbage: %v", err)
+	}
+	if content := readCASFile(t, cas, "b.txt"); content != "content" {
+		t.Fatalf("unexpected content of saved file: %q", content)
+	}
+}
+
+func TestContentAddressedFileSystem_ConcurrentSaveAndCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	cas := MustNewContentAddressedFileSystem(t.TempDir())
+
+	stop := make(chan struct{})
+	gcErr := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				gcErr <- nil
+				return
+			default:
+			}
+			if _, err := cas.CollectGarbage(ctx); err != nil {
+				gcErr <- err
+				return
+			}
+		}
+	}()
+
+	// Every file is saved and deleted, and blob of next file is same, so collection often sees it unreferenced.
+	for i := 0; i < 100; i++ {
+		saveCASFile(t, cas, "b.txt", "content")
+		if err := cas.DeleteFile(ctx, "a.txt"); err != nil && !errors.Is(err, ErrFileNotFound) {
+			t.Fatalf("delete file: %v", err)
+		}
+		if _, err := cas.RenameFile(ctx, "b.txt", "a.txt"); err != nil {
+			t.Fatalf("rename file: %v", err)
+		}
+		if content := readCASFile(t, cas, "a.txt"); content != "content" {
+			t.Fatalf("unexpected content: %q", content)
+		}
+	}
+
+	close(stop)
+	if err := <-gcErr; err != nil {
+		t.Fatalf("collect garbage: %v", err)
+	}
+}
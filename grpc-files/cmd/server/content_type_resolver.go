@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	defaultContentType = "application/octet-stream"
+	sniffLen           = 512
+)
+
+var defaultContentTypesByExtension = map[string]string{
+	".css":  "text/css; charset=utf-8",
+	".csv":  "text/csv; charset=utf-8",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".htm":  "text/html; charset=utf-8",
+	".html": "text/html; charset=utf-8",
+	".js":   "text/javascript; charset=utf-8",
+	".json": "application/json",
+	".md":   "text/markdown; charset=utf-8",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".svg":  "image/svg+xml",
+	".txt":  "text/plain; charset=utf-8",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".xml":  "text/xml; charset=utf-8",
+	".yaml": "application/yaml",
+	".yml":  "application/yaml",
+}
+
+type ContentTypeResolver struct {
+	byExtension map[string]string
+}
+
+func NewContentTypeResolver(byExtension map[string]string) *ContentTypeResolver {
+	r := ContentTypeResolver{
+		byExtension: make(map[string]string, len(defaultContentTypesByExtension)+len(byExtension)),
+	}
+
+	for ext, contentType := range defaultContentTypesByExtension {
+		r.byExtension[ext] = contentType
+	}
+	for ext, contentType := range byExtension {
+		r.byExtension[strings.ToLower(ext)] = contentType
+	}
+
+	return &r
+}
+
+// ParseContentTypesByExtension parses table in format ".ext=type/subtype,.ext2=type/subtype".
+func ParseContentTypesByExtension(table string) (map[string]string, error) {
+	byExtension := make(map[string]string)
+
+	for _, item := range strings.Split(table, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		ext, contentType, ok := strings.Cut(item, "=")
+		if !ok || !strings.HasPrefix(ext, ".") {
+			return nil, fmt.Errorf("invalid item %q, expected .ext=type/subtype", item)
+		}
+
+		if _, _, err := mime.ParseMediaType(contentType); err != nil {
+			return nil, fmt.Errorf("invalid content type of %s: %w", ext, err)
+		}
+
+		byExtension[ext] = contentType
+	}
+
+	return byExtension, nil
+}
+
+// Resolve returns content type passed by client if it is known, else by extension of file name, else detected
+// by first bytes of content. Extension is checked first, because sniffing sees SVG as XML and DOCX as ZIP.
+func (r *ContentTypeResolver) Resolve(clientContentType string, head []byte, name string) string {
+	if clientContentType != "" && clientContentType != defaultContentType {
+		if _, _, err := mime.ParseMediaType(clientContentType); err == nil {
+			return clientContentType
+		}
+	}
+
+	if byName := r.resolveByExtension(name); byName != "" {
+		return byName
+	}
+
+	return http.DetectContentType(head)
+}
+
+// ResolveByName is used for files without saved metadata, when content is not available.
+func (r *ContentTypeResolver) ResolveByName(name string) string {
+	if byName := r.resolveByExtension(name); byName != "" {
+		return byName
+	}
+
+	return defaultContentType
+}
+
+func (r *ContentTypeResolver) resolveByExtension(name string) string {
+	ext := strings.ToLower(filepath.Ext(name))
+	if ext == "" {
+		return ""
+	}
+
+	if contentType, ok := r.byExtension[ext]; ok {
+		return contentType
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+// ContentHeadRecorder remembers first bytes of content which pass through it for detect content type.
+type ContentHeadRecorder struct {
+	content io.Reader
+	head    []byte
+}
+
+func NewContentHeadRecorder(content io.Reader) *ContentHeadRecorder {
+	return &ContentHeadRecorder{
+		content: content,
+		head:    make([]byte, 0, sniffLen),
+	}
+}
+
+func (r *ContentHeadRecorder) Read(dst []byte) (int, error) {
+	n, err := r.content.Read(dst)
+
+	if free := cap(r.head) - len(r.head); free > 0 && n > 0 {
+		if free > n {
+			free = n
+		}
+		r.head = append(r.head, dst[:free]...)
+	}
+
+	return n, err
+}
+
+func (r *ContentHeadRecorder) Head() []byte {
+	return r.head
+}
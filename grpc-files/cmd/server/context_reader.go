@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"io"
+)
+
+// ContextReader stops reading of content when context is done.
+type ContextReader struct {
+	ctx     context.Context
+	content io.Reader
+}
+
+func NewContextReader(ctx context.Context, content io.Reader) *ContextReader {
+	return &ContextReader{
+		ctx:     ctx,
+		content: content,
+	}
+}
+
+func (r *ContextReader) Read(dst []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.content.Read(dst)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// aclAnyone grants access to any authenticated principal.
+	aclAnyone = "*"
+	// aclRolePrefix marks grant to role, for example "role:ops".
+	aclRolePrefix = "role:"
+)
+
+type Permission int
+
+const (
+	PermissionRead Permission = iota
+	PermissionWrite
+	// PermissionManage allows to change ACL of file.
+	PermissionManage
+)
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionRead:
+		return "read"
+	case PermissionWrite:
+		return "write"
+	case PermissionManage:
+		return "manage"
+	default:
+		return fmt.Sprintf("permission(%d)", int(p))
+	}
+}
+
+// FileACL is access list of file. Owner has all permissions, writers can read and write content,
+// readers can only read. Entries of readers and writers are principal ids, "role:<role>" or "*".
+type FileACL struct {
+	Owner   string   `json:"owner"`
+	Readers []string `json:"readers,omitempty"`
+	Writers []string `json:"writers,omitempty"`
+}
+
+func (acl FileACL) Allows(principal *Principal, permission Permission) bool {
+	if principal.ID == acl.Owner || principal.HasRole(adminRole) {
+		return true
+	}
+
+	switch permission {
+	case PermissionRead:
+		return aclContains(acl.Readers, principal) || aclContains(acl.Writers, principal)
+	case PermissionWrite:
+		return aclContains(acl.Writers, principal)
+	default:
+		return false
+	}
+}
+
+func (acl FileACL) Validate() error {
+	if acl.Owner != "" {
+		if err := ValidatePrincipalID(acl.Owner); err != nil {
+			return fmt.Errorf("owner: %w", err)
+		}
+	}
+
+	for _, entries := range [][]string{acl.Readers, acl.Writers} {
+		for _, entry := range entries {
+			if err := validateACLEntry(entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateACLEntry(entry string) error {
+	if entry == aclAnyone {
+		return nil
+	}
+	if role := strings.TrimPrefix(entry, aclRolePrefix); role != entry {
+		if role == "" {
+			return fmt.Errorf("%w: empty role in acl entry", ErrInvalidArgument)
+		}
+		return nil
+	}
+	return ValidatePrincipalID(entry)
+}
+
+func aclContains(entries []string, principal *Principal) bool {
+	for _, entry := range entries {
+		if entry == aclAnyone || entry == principal.ID {
+			return true
+		}
+		if role := strings.TrimPrefix(entry, aclRolePrefix); role != entry && principal.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFileACL_Allows(t *testing.T) {
+	acl := FileACL{Owner: "alice", Readers: []string{"bob", "role:audit"}, Writers: []string{"carol", "role:ops"}}
+
+	tests := []struct {
+		name      string
+		principal Principal
+		read      bool
+		write     bool
+		manage    bool
+	}{
+		{name: "owner", principal: Principal{ID: "alice"}, read: true, write: true, manage: true},
+		{name: "admin", principal: Principal{ID: "root", Roles: []string{adminRole}}, read: true, write: true, manage: true},
+		{name: "reader", principal: Principal{ID: "bob"}, read: true},
+		{name: "reader by role", principal: Principal{ID: "dave", Roles: []string{"audit"}}, read: true},
+		{name: "writer", principal: Principal{ID: "carol"}, read: true, write: true},
+		{name: "writer by role", principal: Principal{ID: "erin", Roles: []string{"dev", "ops"}}, read: true, write: true},
+		{name: "other principal", principal: Principal{ID: "mallory", Roles: []string{"dev"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for permission, allowed := range map[Permission]bool{PermissionRead: tt.read, PermissionWrite: tt.write, PermissionManage: tt.manage} {
+				if got := acl.Allows(&tt.principal, permission); got != allowed {
+					t.Fatalf("expected %s allowed %t, got %t", permission, allowed, got)
+				}
+			}
+		})
+	}
+
+	anyone := FileACL{Owner: "alice", Readers: []string{aclAnyone}}
+	if !anyone.Allows(&Principal{ID: "mallory"}, PermissionRead) || anyone.Allows(&Principal{ID: "mallory"}, PermissionWrite) {
+		t.Fatalf("expected read only access of anyone")
+	}
+}
+
+func TestFileACL_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		acl     FileACL
+		invalid bool
+	}{
+		{name: "empty"},
+		{name: "valid entries", acl: FileACL{Owner: "alice", Readers: []string{"*", "role:ops"}, Writers: []string{"bob"}}},
+		{name: "invalid owner", acl: FileACL{Owner: "a/b"}, invalid: true},
+		{name: "owner with tilde", acl: FileACL{Owner: "~alice"}, invalid: true},
+		{name: "empty role", acl: FileACL{Readers: []string{"role:"}}, invalid: true},
+		{name: "invalid writer", acl: FileACL{Writers: []string{"../bob"}}, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.acl.Validate()
+			if tt.invalid && !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("expected ErrInvalidArgument, got %v", err)
+			}
+			if !tt.invalid && err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
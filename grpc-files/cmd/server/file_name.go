@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidName is ErrInvalidArgument of names of files and folders.
+var ErrInvalidName error = &kindError{msg: "invalid name", kind: ErrInvalidArgument}
+
+const (
+	maxNameLength        = 1024
+	maxNameSegmentLength = 255
+)
+
+// ValidateName checks that name is slash separated path relative to root of files system, which can not
+// escape root and can not point to hidden service files like metadata store or upload staging.
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidName)
+	}
+	if len(name) > maxNameLength {
+		return fmt.Errorf("%w: longer than %d bytes", ErrInvalidName, maxNameLength)
+	}
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("%w: not valid utf-8 string", ErrInvalidName)
+	}
+	if path.IsAbs(name) || path.Clean(name) != name {
+		return fmt.Errorf("%w: %q is not clean relative path", ErrInvalidName, name)
+	}
+
+	for _, segment := range strings.Split(name, "/") {
+		if len(segment) > maxNameSegmentLength {
+			return fmt.Errorf("%w: segment is longer than %d bytes", ErrInvalidName, maxNameSegmentLength)
+		}
+		if strings.HasPrefix(segment, ".") {
+			return fmt.Errorf("%w: segment of %q starts with dot", ErrInvalidName, name)
+		}
+		if strings.ContainsRune(segment, '\\') || strings.IndexFunc(segment, unicode.IsControl) >= 0 {
+			return fmt.Errorf("%w: %q contains backslash or control character", ErrInvalidName, name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateParentName is same as ValidateName, but allows empty name of root folder.
+func ValidateParentName(name string) error {
+	if name == "" {
+		return nil
+	}
+	return ValidateName(name)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "file.txt", valid: true},
+		{name: "folder/file.txt", valid: true},
+		{name: "папка/файл", valid: true},
+		{name: strings.Repeat("a", maxNameSegmentLength), valid: true},
+		{name: ""},
+		{name: "/file.txt"},
+		{name: "folder/"},
+		{name: "folder//file.txt"},
+		{name: "./file.txt"},
+		{name: "../file.txt"},
+		{name: "folder/../file.txt"},
+		{name: ".metadata.json"},
+		{name: "folder/.hidden"},
+		{name: `folder\file.txt`},
+		{name: "file\n.txt"},
+		{name: "file\xff.txt"},
+		{name: strings.Repeat("a", maxNameSegmentLength+1)},
+		{name: strings.Repeat("a/", maxNameLength/2) + "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateName(tt.name)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid name, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidName) {
+				t.Fatalf("expected ErrInvalidName, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("expected ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateParentName(t *testing.T) {
+	if err := ValidateParentName(""); err != nil {
+		t.Fatalf("expected valid root folder, got %v", err)
+	}
+	if err := ValidateParentName("../folder"); !errors.Is(err, ErrInvalidName) {
+		t.Fatalf("expected ErrInvalidName, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// FilesAuthorizer checks access of principal of context to files by ACL saved with file metadata.
+// File without ACL belongs to principal whose folder contains it. Access is not checked
+// if authentication is disabled.
+type FilesAuthorizer struct {
+	metadataStore FilesMetadataStore
+}
+
+func NewFilesAuthorizer(metadataStore FilesMetadataStore) *FilesAuthorizer {
+	return &FilesAuthorizer{metadataStore: metadataStore}
+}
+
+// AuthorizeFile checks permission to file, permission to write new file has only owner of folder.
+func (a *FilesAuthorizer) AuthorizeFile(ctx context.Context, name string, permission Permission) error {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil
+	}
+
+	if principal.Scope != nil && principal.Scope.Name != name {
+		return fmt.Errorf("%w: credentials do not allow access to %s", ErrPermissionDenied, name)
+	}
+
+	acl, err := a.FileACL(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !acl.Allows(principal, permission) {
+		return fmt.Errorf("%w: %s access to %s", ErrPermissionDenied, permission, name)
+	}
+
+	return nil
+}
+
+// AuthorizeFolder checks that principal owns folder of principal which contains name,
+// only owner can create and delete folders and list all files in it.
+func (a *FilesAuthorizer) AuthorizeFolder(ctx context.Context, name string) error {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil
+	}
+
+	if principal.Scope != nil {
+		return fmt.Errorf("%w: credentials do not allow access to folders", ErrPermissionDenied)
+	}
+	if namespaceOwner(ctx, name) != principal.ID && !principal.HasRole(adminRole) {
+		return fmt.Errorf("%w: folder of %s belongs to other principal", ErrPermissionDenied, name)
+	}
+
+	return nil
+}
+
+// FileACL returns ACL of file, default ACL gives access only to owner of folder.
+func (a *FilesAuthorizer) FileACL(ctx context.Context, name string) (FileACL, error) {
+	metadata, err := a.metadataStore.GetFileMetadata(ctx, name)
+	if err != nil {
+		return FileACL{}, fmt.Errorf("get file metadata: %w", err)
+	}
+
+	if metadata == nil || metadata.ACL == nil {
+		return FileACL{Owner: namespaceOwner(ctx, name)}, nil
+	}
+
+	return *metadata.ACL, nil
+}
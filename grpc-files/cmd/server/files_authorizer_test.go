@@ -0,0 +1,176 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFilesAuthorizer_FileACL(t *testing.T) {
+	server := newAPIKeyTestServer(t,
+		Principal{ID: "alice"},
+		Principal{ID: "bob"},
+		Principal{ID: "carol", Roles: []string{"ops"}},
+	)
+	aliceCtx, bobCtx, carolCtx := apiKeyContext("alice"), apiKeyContext("bob"), apiKeyContext("carol")
+
+	for _, name := range []string{"shared.txt", "writable.txt", "private.txt", "folder/nested.txt"} {
+		if _, err := server.upload(aliceCtx, &files.UploadFileRequest_Info{Name: name}, name, 7); err != nil {
+			t.Fatalf("upload %s: %v", name, err)
+		}
+	}
+
+	// File without ACL belongs to owner of folder.
+	acl, err := server.client.GetFileACL(aliceCtx, &files.GetFileACLRequest{Name: "shared.txt"})
+	if err != nil || acl.GetAcl().GetOwner() != "alice" || len(acl.GetAcl().GetReaders()) != 0 {
+		t.Fatalf("unexpected default acl %v, error %v", acl.GetAcl(), err)
+	}
+
+	for name, acl := range map[string]*files.FileACL{
+		"shared.txt":   {Readers: []string{"bob"}},
+		"writable.txt": {Writers: []string{"role:ops"}},
+	} {
+		resp, err := server.client.SetFileACL(aliceCtx, &files.SetFileACLRequest{Name: name, Acl: acl})
+		if err != nil {
+			t.Fatalf("set acl of %s: %v", name, err)
+		}
+		// Owner is kept when it is not passed.
+		if resp.GetAcl().GetOwner() != "alice" {
+			t.Fatalf("unexpected owner of %s: %s", name, resp.GetAcl().GetOwner())
+		}
+	}
+
+	acl, err = server.client.GetFileACL(bobCtx, &files.GetFileACLRequest{Name: "~alice/shared.txt"})
+	if err != nil || acl.GetAcl().GetOwner() != "alice" || strings.Join(acl.GetAcl().GetReaders(), ",") != "bob" {
+		t.Fatalf("unexpected acl of shared file %v, error %v", acl.GetAcl(), err)
+	}
+
+	calls := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{name: "reader downloads", call: func() error {
+			_, _, _, err := server.download(bobCtx, "~alice/shared.txt", 0, 0)
+			return err
+		}},
+		{name: "reader uploads", code: codes.PermissionDenied, call: func() error {
+			_, err := server.upload(bobCtx, &files.UploadFileRequest_Info{Name: "~alice/shared.txt"}, "bob", 7)
+			return err
+		}},
+		{name: "reader changes acl", code: codes.PermissionDenied, call: func() error {
+			_, err := server.client.SetFileACL(bobCtx, &files.SetFileACLRequest{Name: "~alice/shared.txt", Acl: &files.FileACL{Writers: []string{"bob"}}})
+			return err
+		}},
+		{name: "reader deletes", code: codes.PermissionDenied, call: func() error {
+			_, err := server.client.DeleteFile(bobCtx, &files.DeleteFileRequest{Name: "~alice/shared.txt"})
+			return err
+		}},
+		{name: "other principal downloads private file", code: codes.PermissionDenied, call: func() error {
+			_, _, _, err := server.download(bobCtx, "~alice/private.txt", 0, 0)
+			return err
+		}},
+		{name: "other principal gets acl of private file", code: codes.PermissionDenied, call: func() error {
+			_, err := server.client.GetFileACL(bobCtx, &files.GetFileACLRequest{Name: "~alice/private.txt"})
+			return err
+		}},
+		{name: "other principal copies shared file to own folder", call: func() error {
+			_, err := server.client.CopyFile(bobCtx, &files.CopyFileRequest{Name: "~alice/shared.txt", NewName: "copy.txt"})
+			return err
+		}},
+		{name: "writer by role uploads", call: func() error {
+			_, err := server.upload(carolCtx, &files.UploadFileRequest_Info{Name: "~alice/writable.txt"}, "carol", 7)
+			return err
+		}},
+		{name: "writer by role changes acl", code: codes.PermissionDenied, call: func() error {
+			_, err := server.client.SetFileACL(carolCtx, &files.SetFileACLRequest{Name: "~alice/writable.txt", Acl: &files.FileACL{}})
+			return err
+		}},
+		{name: "writer by role creates file", code: codes.PermissionDenied, call: func() error {
+			_, err := server.upload(carolCtx, &files.UploadFileRequest_Info{Name: "~alice/new.txt"}, "carol", 7)
+			return err
+		}},
+		{name: "invalid acl entry", code: codes.InvalidArgument, call: func() error {
+			_, err := server.client.SetFileACL(aliceCtx, &files.SetFileACLRequest{Name: "shared.txt", Acl: &files.FileACL{Readers: []string{"role:"}}})
+			return err
+		}},
+		{name: "acl of missing file", code: codes.NotFound, call: func() error {
+			_, err := server.client.GetFileACL(aliceCtx, &files.GetFileACLRequest{Name: "missing.txt"})
+			return err
+		}},
+	}
+	for _, tt := range calls {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Fatalf("expected code %s, got %v", tt.code, err)
+			}
+		})
+	}
+
+	if _, content, _, err := server.download(aliceCtx, "writable.txt", 0, 0); err != nil || content != "carol" {
+		t.Fatalf("expected content written by writer, got %q, error %v", content, err)
+	}
+
+	// Revoked reader loses access.
+	if _, err = server.client.SetFileACL(aliceCtx, &files.SetFileACLRequest{Name: "shared.txt", Acl: &files.FileACL{}}); err != nil {
+		t.Fatalf("revoke access: %v", err)
+	}
+	if _, err = server.client.GetFileHeader(bobCtx, &files.GetFileHeaderRequest{Name: "~alice/shared.txt"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied after revoke, got %v", err)
+	}
+}
+
+func TestFilesAuthorizer_ListFolderOfOtherPrincipal(t *testing.T) {
+	server := newAPIKeyTestServer(t, Principal{ID: "alice"}, Principal{ID: "bob"})
+	aliceCtx := apiKeyContext("alice")
+
+	for _, name := range []string{"shared.txt", "private.txt", "folder/nested.txt"} {
+		if _, err := server.upload(aliceCtx, &files.UploadFileRequest_Info{Name: name}, name, 7); err != nil {
+			t.Fatalf("upload %s: %v", name, err)
+		}
+	}
+	if _, err := server.client.SetFileACL(aliceCtx, &files.SetFileACLRequest{Name: "shared.txt", Acl: &files.FileACL{Readers: []string{"*"}}}); err != nil {
+		t.Fatalf("set acl: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		caller string
+		parent string
+		code   codes.Code
+		items  string
+	}{
+		{name: "own folder", caller: "alice", parent: "", items: "folder,private.txt,shared.txt"},
+		{name: "missing own folder", caller: "alice", parent: "missing", code: codes.NotFound},
+		{name: "shared files of other principal", caller: "bob", parent: "~alice", items: "~alice/shared.txt"},
+		{name: "folder without shared files", caller: "bob", parent: "~alice/folder"},
+		// Missing folder of other principal looks like folder without shared files.
+		{name: "missing folder of other principal", caller: "bob", parent: "~alice/missing"},
+		{name: "folder of unknown principal", caller: "bob", parent: "~carol"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.client.ListFilesHeader(apiKeyContext(tt.caller), &files.ListFilesHeaderRequest{Parent: tt.parent})
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected code %s, got %v", tt.code, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var names []string
+			for _, item := range resp.GetItems() {
+				names = append(names, item.GetName())
+			}
+			sort.Strings(names)
+			if got := strings.Join(names, ","); got != tt.items {
+				t.Fatalf("expected items %q, got %q", tt.items, got)
+			}
+		})
+	}
+}
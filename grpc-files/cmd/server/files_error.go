@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+)
+
+// Types of resources of FilesError.
+const (
+	fileResource   = "file"
+	folderResource = "folder"
+	uploadResource = "upload"
+)
+
+// FilesError is error of files service about resource or field of request. It wraps error of its kind, like
+// ErrFileNotFound, ErrFileAlreadyExists, ErrInvalidName, ErrQuotaExceeded or context.Canceled, so it is checked
+// by errors.Is, and keeps resource and field, which are sent to client in details of status.
+type FilesError struct {
+	Err error
+	// Resource is type of resource: file, folder or upload, it is empty if error is not about resource.
+	Resource string
+	// Name is name of file or folder, or id of upload.
+	Name string
+	// Field is path of field of request, which value is invalid.
+	Field string
+}
+
+func (e *FilesError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FilesError) Unwrap() error {
+	return e.Err
+}
+
+// resourceError returns err as FilesError about resource, nil and errors, which are already FilesError,
+// are returned as is, so resource of error is set where it is known first.
+func resourceError(err error, resource, name string) error {
+	var filesErr *FilesError
+	if err == nil || errors.As(err, &filesErr) {
+		return err
+	}
+	return &FilesError{Err: err, Resource: resource, Name: name}
+}
+
+// fieldError is resourceError about invalid field of request.
+func fieldError(err error, field string) error {
+	var filesErr *FilesError
+	if err == nil || errors.As(err, &filesErr) {
+		return err
+	}
+	return &FilesError{Err: err, Field: field}
+}
+
+// kindError is sentinel error, which is special case of other sentinel error.
+type kindError struct {
+	msg  string
+	kind error
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
@@ -5,90 +5,840 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"path/filepath"
+	"time"
 )
 
-var ErrFileNotFound = errors.New("file not found")
+var (
+	ErrFileNotFound       = errors.New("file not found")
+	ErrFileAlreadyExists  = errors.New("file already exists")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFolderNotFound     = errors.New("folder not found")
+	ErrFolderNotEmpty     = errors.New("folder is not empty")
+	ErrSignedURLsDisabled = errors.New("signed urls are not configured")
+	// ErrPreconditionFailed is returned when file is changed by other client, so it is not overwritten.
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
 
 type FilesSystem interface {
-	ListFilesInfo(ctx context.Context) ([]FileInfo, error)
+	// ListFilesInfo returns files and folders which are direct children of parent folder, empty parent is root.
+	ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error)
 	SaveFile(ctx context.Context, name string, content io.Reader) (size uint64, err error)
-	ReadFile(ctx context.Context, name string) (size uint64, content io.ReadCloser, err error)
+	// StatFile returns info of file without opening of its content.
+	StatFile(ctx context.Context, name string) (FileInfo, error)
+	// ReadFile returns nil content without error if file does not exist.
+	ReadFile(ctx context.Context, name string) (info FileInfo, content io.ReadSeekCloser, err error)
+	DeleteFile(ctx context.Context, name string) error
+	RenameFile(ctx context.Context, name, newName string) (size uint64, err error)
+	CopyFile(ctx context.Context, name, newName string) (size uint64, err error)
+	// CreateFolder creates folder with all parent folders, it does nothing if folder already exists.
+	CreateFolder(ctx context.Context, name string) error
+	DeleteFolder(ctx context.Context, name string, recursive bool) error
+}
+
+type FilesMetadataStore interface {
+	// GetFileMetadata returns nil metadata without error if file metadata was not saved.
+	GetFileMetadata(ctx context.Context, name string) (*FileMetadata, error)
+	SaveFileMetadata(ctx context.Context, name string, metadata FileMetadata) error
+	DeleteFileMetadata(ctx context.Context, name string) error
+	// DeleteFolderMetadata deletes metadata of all files inside of folder.
+	DeleteFolderMetadata(ctx context.Context, name string) error
 }
 
 type FilesService struct {
-	filesSystem FilesSystem
+	filesSystem         FilesSystem
+	metadataStore       FilesMetadataStore
+	contentTypeResolver *ContentTypeResolver
+	uploadStaging       *UploadStaging
+	authorizer          *FilesAuthorizer
+	quota               *QuotaFilesSystem
+	// signedURLIssuer is nil if signed urls are disabled.
+	signedURLIssuer *SignedURLIssuer
+
+	// nameLocker serializes changes of file, so check of upload preconditions is atomic with save of file
+	// and with other changes of it. Names are namespaced, so same names in folders of principals differ.
+	nameLocker *NameLocker
 }
 
-func NewFilesService(filesSystem FilesSystem) *FilesService {
+func NewFilesService(
+	filesSystem FilesSystem,
+	metadataStore FilesMetadataStore,
+	contentTypeResolver *ContentTypeResolver,
+	uploadStaging *UploadStaging,
+	quota *QuotaFilesSystem,
+	signedURLIssuer *SignedURLIssuer,
+) *FilesService {
 	return &FilesService{
-		filesSystem: filesSystem,
+		filesSystem:         filesSystem,
+		metadataStore:       metadataStore,
+		contentTypeResolver: contentTypeResolver,
+		uploadStaging:       uploadStaging,
+		authorizer:          NewFilesAuthorizer(metadataStore),
+		quota:               quota,
+		signedURLIssuer:     signedURLIssuer,
+		nameLocker:          NewNameLocker(),
 	}
 }
 
 type FileInfo struct {
-	Name string
-	Size uint64
+	Name       string
+	Size       uint64
+	ModifiedAt time.Time
+	IsFolder   bool
+}
+
+type FileMetadata struct {
+	ContentType string    `json:"content_type"`
+	Uploader    string    `json:"uploader,omitempty"`
+	SHA256      string    `json:"sha256,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// ACL is nil if authentication is disabled or file was saved before it was enabled.
+	ACL *FileACL `json:"acl,omitempty"`
 }
 
 type FileHeader struct {
 	Name        string
 	ContentType string
 	Size        uint64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	SHA256      string
+	IsFolder    bool
+	ETag        string
 }
 
-func (s *FilesService) ListFilesHeader(ctx context.Context) ([]FileHeader, error) {
-	filesInfo, err := s.filesSystem.ListFilesInfo(ctx)
+type UploadInfo struct {
+	// ID is empty for start new upload.
+	ID          string
+	Name        string
+	ContentType string
+	Size        uint64
+	Checksums   FileChecksums
+	// IfMatch is list of etags, one of them current file must have, "*" matches any existing file.
+	IfMatch []string
+}
+
+type uploaderContextKey struct{}
+
+func ContextWithUploader(ctx context.Context, uploader string) context.Context {
+	return context.WithValue(ctx, uploaderContextKey{}, uploader)
+}
+
+func UploaderFromContext(ctx context.Context) string {
+	uploader, _ := ctx.Value(uploaderContextKey{}).(string)
+	return uploader
+}
+
+func (s *FilesService) ListFilesHeader(ctx context.Context, q ListFilesQuery) (*FilesHeaderPage, error) {
+	if err := ValidateParentName(q.Parent); err != nil {
+		return nil, fieldError(err, "parent")
+	}
+
+	// Only files shared with principal are listed in folder of other principal. Folder is authorized
+	// before it is read, and missing folder of other principal is empty, so its existence is not revealed.
+	err := s.authorizer.AuthorizeFolder(ctx, q.Parent)
+	shared := errors.Is(err, ErrPermissionDenied)
+	if err != nil && !shared {
+		return nil, fmt.Errorf("authorize folder: %w", err)
+	}
+
+	filesInfo, err := s.filesSystem.ListFilesInfo(ctx, q.Parent)
+	if shared && errors.Is(err, ErrFolderNotFound) {
+		filesInfo, err = make([]FileInfo, 0), nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("get list of files info: %w", err)
+		return nil, fmt.Errorf("get list of files info: %w", resourceError(err, folderResource, q.Parent))
+	}
+
+	if shared {
+		if filesInfo, err = s.selectReadableFiles(ctx, filesInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	filesInfo, nextPageToken, totalSize, err := selectFilesPage(filesInfo, q)
+	if err != nil {
+		return nil, fmt.Errorf("select page of files: %w", err)
 	}
 
 	filesHeader := make([]FileHeader, len(filesInfo))
 
 	for i := range filesInfo {
-		extension := filepath.Ext(filesInfo[i].Name)
+		if filesInfo[i].IsFolder {
+			filesHeader[i] = newFolderHeader(filesInfo[i])
+			continue
+		}
 
-		filesHeader[i] = FileHeader{
-			Name:        filesInfo[i].Name,
-			ContentType: extension, // TODO: detect content type by extension.
-			Size:        filesInfo[i].Size,
+		h, err := s.fileHeader(ctx, filesInfo[i].Name, filesInfo[i].Size, filesInfo[i].ModifiedAt)
+		if err != nil {
+			return nil, fmt.Errorf("get file header of %s: %w", filesInfo[i].Name, err)
 		}
+
+		filesHeader[i] = *h
 	}
 
-	return filesHeader, nil
+	return &FilesHeaderPage{
+		Items:         filesHeader,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
 }
 
-func (s *FilesService) UploadFile(ctx context.Context, name string, fileContent io.Reader) (*FileHeader, error) {
-	extension := filepath.Ext(name)
+// UploadFile saves file content with name, content type, checksums and preconditions of info.
+// Content is not saved if checksums of content are not matched with expected.
+func (s *FilesService) UploadFile(ctx context.Context, info UploadInfo, fileContent io.Reader) (*FileHeader, error) {
+	name := info.Name
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "file_info.name")
+	}
+
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionWrite); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+
+	defer s.lockFiles(ctx, name)()
 
-	size, err := s.filesSystem.SaveFile(ctx, name, fileContent)
+	if err := s.checkIfMatch(ctx, name, info.IfMatch); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+
+	prevMetadata, err := s.metadataStore.GetFileMetadata(ctx, name)
 	if err != nil {
-		return nil, fmt.Errorf("save file in file system: %w", err)
+		return nil, fmt.Errorf("get previous file metadata: %w", err)
 	}
 
-	h := FileHeader{
-		Name:        name,
-		ContentType: extension, // TODO: detect content type by extension.
-		Size:        size,
+	checksumReader := NewChecksumReader(fileContent, info.Checksums)
+	headRecorder := NewContentHeadRecorder(checksumReader)
+
+	size, err := s.filesSystem.SaveFile(ctx, name, headRecorder)
+	if err != nil {
+		return nil, fmt.Errorf("save file in file system: %w", resourceError(err, fileResource, name))
+	}
+
+	now := time.Now().UTC()
+	metadata := FileMetadata{
+		ContentType: s.contentTypeResolver.Resolve(info.ContentType, headRecorder.Head(), name),
+		Uploader:    UploaderFromContext(ctx),
+		SHA256:      checksumReader.SHA256(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ACL:         defaultFileACL(ctx, name),
+	}
+	if prevMetadata != nil && !prevMetadata.CreatedAt.IsZero() {
+		metadata.CreatedAt = prevMetadata.CreatedAt
+	}
+	if prevMetadata != nil && prevMetadata.ACL != nil {
+		metadata.ACL = prevMetadata.ACL
+	}
+
+	if err = s.metadataStore.SaveFileMetadata(ctx, name, metadata); err != nil {
+		return nil, fmt.Errorf("save file metadata: %w", err)
+	}
+
+	return newFileHeader(name, size, metadata), nil
+}
+
+// OpenUpload starts new upload or resumes upload by id. Upload is locked for caller until unlock is called.
+func (s *FilesService) OpenUpload(ctx context.Context, info UploadInfo) (session *UploadSession, unlock func(), err error) {
+	if info.ID == "" {
+		if err = ValidateName(info.Name); err != nil {
+			return nil, nil, fieldError(err, "file_info.name")
+		}
+		// Permission and preconditions are checked before content is received too,
+		// so client does not send content in vain.
+		if err = s.authorizer.AuthorizeFile(ctx, info.Name, PermissionWrite); err != nil {
+			return nil, nil, resourceError(err, fileResource, info.Name)
+		}
+		if err = s.checkIfMatch(ctx, info.Name, info.IfMatch); err != nil {
+			return nil, nil, resourceError(err, fileResource, info.Name)
+		}
+		if info.Size > 0 {
+			allowance, err := s.quota.Allowance(ctx, namespacedName(ctx, info.Name))
+			if err != nil {
+				return nil, nil, fmt.Errorf("get allowed size of file: %w", err)
+			}
+			if info.Size > allowance {
+				return nil, nil, resourceError(fmt.Errorf("%w: file can not be larger than %d bytes", ErrQuotaExceeded, allowance), fileResource, info.Name)
+			}
+		}
+
+		session, err = s.uploadStaging.CreateSession(ctx, info)
+		if err != nil {
+			return nil, nil, fmt.Errorf("create upload session: %w", err)
+		}
 	}
 
-	return &h, nil
+	if session == nil {
+		session, err = s.uploadStaging.GetSession(ctx, info.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get upload session: %w", resourceError(err, uploadResource, info.ID))
+		}
+		// Upload of other principal is not visible.
+		if !session.OwnedBy(ctx) {
+			return nil, nil, resourceError(ErrUploadNotFound, uploadResource, info.ID)
+		}
+
+		if info.Name != "" && info.Name != session.Name {
+			return nil, nil, fieldError(fmt.Errorf("%w: upload %s was started for other file name", ErrInvalidArgument, info.ID), "file_info.name")
+		}
+		if !info.Checksums.Empty() && !info.Checksums.Equal(session.Checksums) {
+			return nil, nil, fieldError(fmt.Errorf("%w: upload %s was started with other checksums", ErrInvalidArgument, info.ID), "file_info.sha256")
+		}
+	}
+
+	unlock, err = s.uploadStaging.Lock(session.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("lock upload: %w", resourceError(err, uploadResource, session.ID))
+	}
+
+	// Offset might be changed by other stream before lock.
+	if info.ID != "" {
+		if session, err = s.uploadStaging.GetSession(ctx, info.ID); err != nil {
+			unlock()
+			return nil, nil, fmt.Errorf("get locked upload session: %w", err)
+		}
+	}
+
+	return session, unlock, nil
 }
 
-func (s *FilesService) DownloadFile(ctx context.Context, name string) (*FileHeader, io.ReadCloser, error) {
-	size, fileContent, err := s.filesSystem.ReadFile(ctx, name)
+// ContinueUpload saves next part of file content in upload staging. When all content is received,
+// file is saved in files system and its header is returned, else header is nil.
+func (s *FilesService) ContinueUpload(ctx context.Context, session *UploadSession, fileContent io.Reader) (*FileHeader, error) {
+	// Staged content is limited too, so staging does not fill disk with file which can not be saved.
+	allowance, err := s.quota.Allowance(ctx, namespacedName(ctx, session.Name))
 	if err != nil {
-		return nil, nil, fmt.Errorf("start read file: %w", err)
+		return nil, fmt.Errorf("get allowed size of file: %w", err)
+	}
+	if allowance < session.Offset {
+		return nil, resourceError(fmt.Errorf("%w: file can not be larger than %d bytes", ErrQuotaExceeded, allowance), fileResource, session.Name)
+	}
+	fileContent = NewSizeLimitReader(fileContent, allowance-session.Offset)
+
+	if err = s.uploadStaging.Append(ctx, session, fileContent); err != nil {
+		return nil, fmt.Errorf("append content to upload: %w", resourceError(err, uploadResource, session.ID))
+	}
+
+	if !session.Completed() {
+		return nil, nil
+	}
+
+	stagedContent, err := s.uploadStaging.OpenContent(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("open staged content: %w", err)
+	}
+	defer stagedContent.Close()
+
+	h, err := s.UploadFile(ctx, UploadInfo{
+		Name:        session.Name,
+		ContentType: session.ContentType,
+		Checksums:   session.Checksums,
+		IfMatch:     session.IfMatch,
+	}, stagedContent)
+	if errors.Is(err, ErrChecksumMismatch) {
+		// Received content is corrupted, so upload can not be resumed and it is started again.
+		if deleteErr := s.uploadStaging.DeleteSession(ctx, session.ID); deleteErr != nil {
+			return nil, fmt.Errorf("delete corrupted upload session: %w", deleteErr)
+		}
+		return nil, fmt.Errorf("upload staged file: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("upload staged file: %w", err)
+	}
+
+	if err = s.uploadStaging.DeleteSession(ctx, session.ID); err != nil {
+		return nil, fmt.Errorf("delete completed upload session: %w", err)
+	}
+
+	return h, nil
+}
+
+func (s *FilesService) GetUploadStatus(ctx context.Context, uploadID string) (*UploadSession, error) {
+	session, err := s.uploadStaging.GetSession(ctx, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("get upload session: %w", resourceError(err, uploadResource, uploadID))
+	}
+	if !session.OwnedBy(ctx) {
+		return nil, resourceError(ErrUploadNotFound, uploadResource, uploadID)
+	}
+
+	return session, nil
+}
+
+// DownloadFile returns header of whole file and content of range from offset with max length.
+// Negative offset is counted from end of file, zero length means read to end of file.
+// When whole file is downloaded, content is checked with SHA-256 saved on upload.
+func (s *FilesService) DownloadFile(ctx context.Context, name string, offset int64, length uint64) (*FileHeader, *DownloadContent, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, nil, fieldError(err, "name")
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionRead); err != nil {
+		return nil, nil, resourceError(err, fileResource, name)
+	}
+
+	info, fileContent, err := s.filesSystem.ReadFile(ctx, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("start read file: %w", resourceError(err, fileResource, name))
 	}
 	if fileContent == nil {
-		return nil, nil, ErrFileNotFound
+		return nil, nil, resourceError(ErrFileNotFound, fileResource, name)
+	}
+
+	size := info.Size
+	h, err := s.fileHeader(ctx, name, size, info.ModifiedAt)
+	if err != nil {
+		fileContent.Close()
+		return nil, nil, fmt.Errorf("get file header: %w", err)
+	}
+
+	start, n := resolveFileRange(size, offset, length)
+	if start > 0 {
+		if _, err = fileContent.Seek(int64(start), io.SeekStart); err != nil {
+			fileContent.Close()
+			return nil, nil, fmt.Errorf("seek to start of range: %w", err)
+		}
+	}
+
+	var expected FileChecksums
+	if start == 0 && n == size {
+		expected.SHA256 = h.SHA256
+	}
+
+	return h, &DownloadContent{
+		ChecksumReader: NewChecksumReader(io.LimitReader(fileContent, int64(n)), expected),
+		Closer:         fileContent,
+	}, nil
+}
+
+func resolveFileRange(size uint64, offset int64, length uint64) (start, n uint64) {
+	switch {
+	case offset < 0 && uint64(-offset) >= size:
+		start = 0
+	case offset < 0:
+		start = size - uint64(-offset)
+	case uint64(offset) >= size:
+		return size, 0
+	default:
+		start = uint64(offset)
 	}
 
-	extension := filepath.Ext(name)
+	n = size - start
+	if length > 0 && length < n {
+		n = length
+	}
+
+	return start, n
+}
+
+// DownloadContent is content of downloaded range, its digests are known when it is read to end.
+type DownloadContent struct {
+	*ChecksumReader
+	io.Closer
+}
+
+func (s *FilesService) GetFileHeader(ctx context.Context, name string) (*FileHeader, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "name")
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionRead); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+
+	h, err := s.statFileHeader(ctx, name)
+	if err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+
+	return h, nil
+}
+
+func (s *FilesService) DeleteFile(ctx context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return fieldError(err, "name")
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionWrite); err != nil {
+		return resourceError(err, fileResource, name)
+	}
+
+	defer s.lockFiles(ctx, name)()
+
+	if err := s.filesSystem.DeleteFile(ctx, name); err != nil {
+		return fmt.Errorf("delete file from file system: %w", resourceError(err, fileResource, name))
+	}
+
+	if err := s.metadataStore.DeleteFileMetadata(ctx, name); err != nil {
+		return fmt.Errorf("delete file metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesService) RenameFile(ctx context.Context, name, newName string) (*FileHeader, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "name")
+	}
+	if err := ValidateName(newName); err != nil {
+		return nil, fieldError(err, "new_name")
+	}
+
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionWrite); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, newName, PermissionWrite); err != nil {
+		return nil, resourceError(err, fileResource, newName)
+	}
+
+	defer s.lockFiles(ctx, name, newName)()
 
+	size, err := s.filesSystem.RenameFile(ctx, name, newName)
+	if errors.Is(err, ErrFileAlreadyExists) {
+		return nil, resourceError(err, fileResource, newName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("rename file in file system: %w", resourceError(err, fileResource, name))
+	}
+
+	metadata, err := s.metadataStore.GetFileMetadata(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get file metadata: %w", err)
+	}
+	if metadata == nil {
+		return s.statFileHeader(ctx, newName)
+	}
+	// File moved to folder of other principal belongs to it.
+	if namespaceOwner(ctx, name) != namespaceOwner(ctx, newName) {
+		metadata.ACL = defaultFileACL(ctx, newName)
+	}
+
+	if err = s.metadataStore.SaveFileMetadata(ctx, newName, *metadata); err != nil {
+		return nil, fmt.Errorf("save file metadata with new name: %w", err)
+	}
+
+	if err = s.metadataStore.DeleteFileMetadata(ctx, name); err != nil {
+		return nil, fmt.Errorf("delete file metadata of old name: %w", err)
+	}
+
+	return newFileHeader(newName, size, *metadata), nil
+}
+
+func (s *FilesService) CopyFile(ctx context.Context, name, newName string) (*FileHeader, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "name")
+	}
+	if err := ValidateName(newName); err != nil {
+		return nil, fieldError(err, "new_name")
+	}
+
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionRead); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, newName, PermissionWrite); err != nil {
+		return nil, resourceError(err, fileResource, newName)
+	}
+
+	defer s.lockFiles(ctx, name, newName)()
+
+	size, err := s.filesSystem.CopyFile(ctx, name, newName)
+	if errors.Is(err, ErrFileAlreadyExists) {
+		return nil, resourceError(err, fileResource, newName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("copy file in file system: %w", resourceError(err, fileResource, name))
+	}
+
+	metadata, err := s.metadataStore.GetFileMetadata(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get file metadata: %w", err)
+	}
+	if metadata == nil {
+		return s.statFileHeader(ctx, newName)
+	}
+
+	now := time.Now().UTC()
+	metadata.Uploader = UploaderFromContext(ctx)
+	metadata.CreatedAt = now
+	metadata.UpdatedAt = now
+	metadata.ACL = defaultFileACL(ctx, newName)
+
+	if err = s.metadataStore.SaveFileMetadata(ctx, newName, *metadata); err != nil {
+		return nil, fmt.Errorf("save file metadata of copy: %w", err)
+	}
+
+	return newFileHeader(newName, size, *metadata), nil
+}
+
+// GetUsage returns usage of folder of principal and of whole storage.
+func (s *FilesService) GetUsage(ctx context.Context) UsageReport {
+	return s.quota.Usage(namespaceOwner(ctx, ""))
+}
+
+func (s *FilesService) CreateFolder(ctx context.Context, name string) (*FileHeader, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "name")
+	}
+	if err := s.authorizer.AuthorizeFolder(ctx, name); err != nil {
+		return nil, resourceError(err, folderResource, name)
+	}
+
+	if err := s.filesSystem.CreateFolder(ctx, name); err != nil {
+		return nil, fmt.Errorf("create folder in file system: %w", resourceError(err, folderResource, name))
+	}
+
+	return &FileHeader{
+		Name:     name,
+		IsFolder: true,
+	}, nil
+}
+
+func (s *FilesService) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	if err := ValidateName(name); err != nil {
+		return fieldError(err, "name")
+	}
+	if err := s.authorizer.AuthorizeFolder(ctx, name); err != nil {
+		return resourceError(err, folderResource, name)
+	}
+
+	if err := s.filesSystem.DeleteFolder(ctx, name, recursive); err != nil {
+		return fmt.Errorf("delete folder from file system: %w", resourceError(err, folderResource, name))
+	}
+
+	if err := s.metadataStore.DeleteFolderMetadata(ctx, name); err != nil {
+		return fmt.Errorf("delete metadata of folder files: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesService) GetFileACL(ctx context.Context, name string) (*FileACL, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "name")
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionRead); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+
+	if _, err := s.filesSystem.StatFile(ctx, name); err != nil {
+		return nil, fmt.Errorf("get file info: %w", resourceError(err, fileResource, name))
+	}
+
+	acl, err := s.authorizer.FileACL(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &acl, nil
+}
+
+// SetFileACL replaces readers and writers of file, owner is changed only if new owner is not empty.
+func (s *FilesService) SetFileACL(ctx context.Context, name string, acl FileACL) (*FileACL, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, fieldError(err, "name")
+	}
+	if err := acl.Validate(); err != nil {
+		return nil, fieldError(err, "acl")
+	}
+	if err := s.authorizer.AuthorizeFile(ctx, name, PermissionManage); err != nil {
+		return nil, resourceError(err, fileResource, name)
+	}
+
+	defer s.lockFiles(ctx, name)()
+
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get file info: %w", resourceError(err, fileResource, name))
+	}
+
+	metadata, err := s.metadataStore.GetFileMetadata(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get file metadata: %w", err)
+	}
+	// Metadata of file put to file system not over service is saved with what is known by file system,
+	// so header and etag of file are not changed.
+	if metadata == nil {
+		metadata = &FileMetadata{UpdatedAt: info.ModifiedAt}
+	}
+
+	if acl.Owner == "" {
+		currentACL, err := s.authorizer.FileACL(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		acl.Owner = currentACL.Owner
+	}
+	metadata.ACL = &acl
+
+	if err = s.metadataStore.SaveFileMetadata(ctx, name, *metadata); err != nil {
+		return nil, fmt.Errorf("save file metadata: %w", err)
+	}
+
+	return &acl, nil
+}
+
+// CreateSignedURL returns URL of download of file for GET method and URL of upload for POST method,
+// zero lifetime is default lifetime.
+func (s *FilesService) CreateSignedURL(ctx context.Context, name, method string, lifetime time.Duration) (string, time.Time, error) {
+	if s.signedURLIssuer == nil {
+		return "", time.Time{}, ErrSignedURLsDisabled
+	}
+	if err := ValidateName(name); err != nil {
+		return "", time.Time{}, fieldError(err, "name")
+	}
+
+	if lifetime == 0 {
+		lifetime = defaultSignedURLLifetime
+	}
+	if lifetime < 0 || lifetime > maxSignedURLLifetime {
+		return "", time.Time{}, fieldError(fmt.Errorf("%w: lifetime of url must be positive and not longer than %s", ErrInvalidArgument, maxSignedURLLifetime), "expires_in")
+	}
+
+	switch method {
+	case "GET":
+		if err := s.authorizer.AuthorizeFile(ctx, name, PermissionRead); err != nil {
+			return "", time.Time{}, resourceError(err, fileResource, name)
+		}
+		if _, err := s.filesSystem.StatFile(ctx, name); err != nil {
+			return "", time.Time{}, fmt.Errorf("get file info: %w", resourceError(err, fileResource, name))
+		}
+	case "POST":
+		if err := s.authorizer.AuthorizeFile(ctx, name, PermissionWrite); err != nil {
+			return "", time.Time{}, resourceError(err, fileResource, name)
+		}
+	default:
+		return "", time.Time{}, fieldError(fmt.Errorf("%w: method %q is not allowed for signed url", ErrInvalidArgument, method), "method")
+	}
+
+	var principalID string
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		principalID = principal.ID
+	}
+
+	expiresAt := time.Now().Add(lifetime).UTC().Truncate(time.Second)
+
+	signedURL, err := s.signedURLIssuer.Issue(principalID, method, name, expiresAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("issue signed url: %w", err)
+	}
+
+	return signedURL, expiresAt, nil
+}
+
+// selectReadableFiles filters files which principal can read, folders are skipped.
+func (s *FilesService) selectReadableFiles(ctx context.Context, filesInfo []FileInfo) ([]FileInfo, error) {
+	readable := make([]FileInfo, 0)
+
+	for _, info := range filesInfo {
+		if info.IsFolder {
+			continue
+		}
+
+		err := s.authorizer.AuthorizeFile(ctx, info.Name, PermissionRead)
+		if errors.Is(err, ErrPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("authorize file %s: %w", info.Name, err)
+		}
+
+		readable = append(readable, info)
+	}
+
+	return readable, nil
+}
+
+// lockFiles locks files for change, it returns unlock.
+func (s *FilesService) lockFiles(ctx context.Context, names ...string) (unlock func()) {
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = namespacedName(ctx, name)
+	}
+	return s.nameLocker.Lock(keys...)
+}
+
+// checkIfMatch checks that current file has one of etags, empty list is not checked.
+func (s *FilesService) checkIfMatch(ctx context.Context, name string, ifMatch []string) error {
+	if len(ifMatch) == 0 {
+		return nil
+	}
+
+	h, err := s.statFileHeader(ctx, name)
+	if errors.Is(err, ErrFileNotFound) {
+		return fmt.Errorf("%w: file %s does not exist", ErrPreconditionFailed, name)
+	}
+	if err != nil {
+		return fmt.Errorf("get header of current file: %w", err)
+	}
+
+	for _, etag := range ifMatch {
+		if etag == "*" || etag == h.ETag {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: etag of file %s is %s", ErrPreconditionFailed, name, h.ETag)
+}
+
+func (s *FilesService) statFileHeader(ctx context.Context, name string) (*FileHeader, error) {
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get file info: %w", err)
+	}
+
+	return s.fileHeader(ctx, name, info.Size, info.ModifiedAt)
+}
+
+// fileHeader builds header from saved metadata, or from what is known by file system
+// when file was put to file system not over service.
+func (s *FilesService) fileHeader(ctx context.Context, name string, size uint64, modifiedAt time.Time) (*FileHeader, error) {
+	metadata, err := s.metadataStore.GetFileMetadata(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get file metadata: %w", err)
+	}
+
+	if metadata == nil {
+		metadata = &FileMetadata{
+			UpdatedAt: modifiedAt,
+		}
+	}
+	if metadata.ContentType == "" {
+		metadata.ContentType = s.contentTypeResolver.ResolveByName(name)
+	}
+
+	return newFileHeader(name, size, *metadata), nil
+}
+
+// defaultFileACL gives access to new file only to owner of folder.
+func defaultFileACL(ctx context.Context, name string) *FileACL {
+	if PrincipalFromContext(ctx) == nil {
+		return nil
+	}
+	return &FileACL{Owner: namespaceOwner(ctx, name)}
+}
+
+func newFileHeader(name string, size uint64, metadata FileMetadata) *FileHeader {
 	return &FileHeader{
 		Name:        name,
-		ContentType: extension, // TODO: detect content type by extension.
+		ContentType: metadata.ContentType,
 		Size:        size,
-	}, fileContent, nil
+		CreatedAt:   metadata.CreatedAt,
+		UpdatedAt:   metadata.UpdatedAt,
+		SHA256:      metadata.SHA256,
+		ETag:        fileETag(size, metadata),
+	}
+}
+
+// fileETag is SHA-256 of content if it is known, else it is built from size and time of update,
+// like etag of static files in nginx.
+func fileETag(size uint64, metadata FileMetadata) string {
+	if metadata.SHA256 != "" {
+		return metadata.SHA256
+	}
+	return fmt.Sprintf("%x-%x", metadata.UpdatedAt.UnixNano(), size)
+}
+
+func newFolderHeader(info FileInfo) FileHeader {
+	return FileHeader{
+		Name:      info.Name,
+		UpdatedAt: info.ModifiedAt,
+		IsFolder:  true,
+	}
 }
@@ -6,26 +6,34 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 
 	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const uploadIDHeader = "upload-id"
+
 type FilesServiceServer struct {
 	files.UnimplementedFilesServiceServer
 
 	service               *FilesService
 	uploadFileBufferSize  int
 	downloadFileChunkSize int
+	// streamBytesPerSecond caps bandwidth of every upload and download stream, zero is unlimited.
+	streamBytesPerSecond int
 }
 
-func NewFilesServiceServer(service *FilesService) *FilesServiceServer {
+func NewFilesServiceServer(service *FilesService, uploadFileBufferSize, downloadFileChunkSize, streamBytesPerSecond int) *FilesServiceServer {
 	return &FilesServiceServer{
 		service:               service,
-		uploadFileBufferSize:  1024,
-		downloadFileChunkSize: 1024,
+		uploadFileBufferSize:  uploadFileBufferSize,
+		downloadFileChunkSize: downloadFileChunkSize,
+		streamBytesPerSecond:  streamBytesPerSecond,
 	}
 }
 
@@ -33,126 +41,439 @@ func (s *FilesServiceServer) RegistrationGRPC(r grpc.ServiceRegistrar) {
 	files.RegisterFilesServiceServer(r, s)
 }
 
-func (s *FilesServiceServer) ListFilesHeader(ctx context.Context, _ *files.ListFilesHeaderRequest) (resp *files.ListFilesHeaderResponse, err error) {
-	fileHeaders, err := s.service.ListFilesHeader(ctx)
+func (s *FilesServiceServer) ListFilesHeader(ctx context.Context, req *files.ListFilesHeaderRequest) (resp *files.ListFilesHeaderResponse, err error) {
+	page, err := s.service.ListFilesHeader(ctx, ListFilesQuery{
+		Parent:      req.GetParent(),
+		PageSize:    int(req.GetPageSize()),
+		PageToken:   req.GetPageToken(),
+		NamePrefix:  req.GetNamePrefix(),
+		NamePattern: req.GetNamePattern(),
+		OrderBy:     req.GetOrderBy(),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("get list of files header")
+		return nil, filesStatusError(ctx, err)
 	}
 
-	items := make([]*files.FileHeader, len(fileHeaders))
+	items := make([]*files.FileHeader, len(page.Items))
 
-	for i := range fileHeaders {
-		items[i] = &files.FileHeader{
-			Name:        fileHeaders[i].Name,
-			ContentType: fileHeaders[i].ContentType,
-			Size:        fileHeaders[i].Size,
-		}
+	for i := range page.Items {
+		items[i] = newFileHeaderProto(&page.Items[i])
 	}
 
 	return &files.ListFilesHeaderResponse{
-		Items: items,
+		Items:         items,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     int32(page.TotalSize),
 	}, nil
 }
 
 func (s *FilesServiceServer) UploadFile(stream files.FilesService_UploadFileServer) error {
 	msg, err := stream.Recv()
 	if err != nil {
-		return fmt.Errorf("read first message: %w", err)
+		return filesStatusError(stream.Context(), fmt.Errorf("read first message: %w", err))
 	}
 
 	fileInfo := msg.GetFileInfo()
 	if fileInfo == nil {
-		return status.Error(codes.Internal, "first message need have type of UploadFileRequest_Info")
+		return filesStatusError(stream.Context(), fieldError(fmt.Errorf("%w: first message need have type of UploadFileRequest_Info", ErrInvalidArgument), "file_info"))
 	}
 
-	fileReader := bufio.NewReaderSize(NewFileContentReader(stream), s.uploadFileBufferSize)
+	ctx := withUploader(stream.Context())
 
-	fileHeader, err := s.service.UploadFile(stream.Context(), fileInfo.GetName(), fileReader)
+	session, unlock, err := s.service.OpenUpload(ctx, UploadInfo{
+		ID:          fileInfo.GetUploadId(),
+		Name:        fileInfo.GetName(),
+		ContentType: fileInfo.GetContentType(),
+		Size:        fileInfo.GetSize(),
+		Checksums:   newFileChecksums(fileInfo),
+		IfMatch:     fileInfo.GetIfMatch(),
+	})
 	if err != nil {
-		return fmt.Errorf("handle upload file: %w", err)
+		return filesStatusError(ctx, err)
 	}
+	defer unlock()
 
-	err = stream.SendAndClose(&files.UploadFileResponse{
-		FileHeader: &files.FileHeader{
-			Name:        fileHeader.Name,
-			ContentType: fileHeader.ContentType,
-			Size:        fileHeader.Size,
-		},
-	})
+	// Client gets id of upload before sending of content for resume upload if stream is broken.
+	if err = stream.SendHeader(metadata.Pairs(uploadIDHeader, session.ID)); err != nil {
+		return filesStatusError(ctx, fmt.Errorf("send upload id header: %w", err))
+	}
+
+	fileReader := bufio.NewReaderSize(NewFileContentReader(stream, session.Offset, session.Size, NewBandwidthLimiter(s.streamBytesPerSecond)), s.uploadFileBufferSize)
+
+	fileHeader, err := s.service.ContinueUpload(ctx, session, fileReader)
+	if errors.Is(err, ErrInvalidUploadOffset) {
+		// Client resumes upload from committed offset.
+		err = fmt.Errorf("%w, committed offset %d", err, session.Offset)
+	}
 	if err != nil {
-		return fmt.Errorf("send response and close stream: %w", err)
+		return filesStatusError(ctx, err)
+	}
+
+	resp := files.UploadFileResponse{
+		UploadId:        session.ID,
+		CommittedOffset: session.Offset,
+	}
+	if fileHeader != nil {
+		resp.FileHeader = newFileHeaderProto(fileHeader)
+	}
+
+	if err = stream.SendAndClose(&resp); err != nil {
+		return filesStatusError(ctx, fmt.Errorf("send response and close stream: %w", err))
 	}
 
 	return nil
 }
 
-func (s *FilesServiceServer) DownloadFile(req *files.DownloadFileRequest, stream files.FilesService_DownloadFileServer) error {
-	fileHeader, fileContent, err := s.service.DownloadFile(stream.Context(), req.Name)
-	if errors.Is(err, ErrFileNotFound) {
-		return status.Error(codes.NotFound, ErrFileNotFound.Error())
+func (s *FilesServiceServer) GetUploadStatus(ctx context.Context, req *files.GetUploadStatusRequest) (*files.GetUploadStatusResponse, error) {
+	session, err := s.service.GetUploadStatus(ctx, req.GetUploadId())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
 	}
+
+	return &files.GetUploadStatusResponse{
+		UploadId:        session.ID,
+		Name:            session.Name,
+		CommittedOffset: session.Offset,
+		Size:            session.Size,
+	}, nil
+}
+
+func (s *FilesServiceServer) DownloadFile(req *files.DownloadFileRequest, stream files.FilesService_DownloadFileServer) error {
+	fileHeader, fileContent, err := s.service.DownloadFile(stream.Context(), req.GetName(), req.GetOffset(), req.GetLength())
 	if err != nil {
-		return fmt.Errorf("download file: %w", err)
+		return filesStatusError(stream.Context(), err)
 	}
+	defer fileContent.Close()
 
 	err = stream.Send(&files.DownloadFileResponse{
 		Data: &files.DownloadFileResponse_FileHeader{
-			FileHeader: &files.FileHeader{
-				Name:        fileHeader.Name,
-				ContentType: fileHeader.ContentType,
-				Size:        fileHeader.Size,
-			},
+			FileHeader: newFileHeaderProto(fileHeader),
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("send file header to stream: %w", err)
+		return filesStatusError(stream.Context(), fmt.Errorf("send file header to stream: %w", err))
 	}
 
+	// Chunk is sent only after next chunk is read, so last chunk is not sent if content is not matched
+	// with checksum and client does not get whole corrupted content.
 	chunk := make([]byte, s.downloadFileChunkSize)
+	next := make([]byte, s.downloadFileChunkSize)
+	var pending []byte
+	limiter := NewBandwidthLimiter(s.streamBytesPerSecond)
 
 	for {
-		n, err := fileContent.Read(chunk[:])
-		if errors.Is(err, io.EOF) {
+		// Reader may return last bytes of content together with EOF.
+		n, readErr := fileContent.Read(next)
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return filesStatusError(stream.Context(), fmt.Errorf("read chunk of file content: %w", readErr))
+		}
+
+		if len(pending) > 0 {
+			if err = sendFileContentChunk(stream, limiter, pending); err != nil {
+				return filesStatusError(stream.Context(), err)
+			}
+		}
+
+		chunk, next = next, chunk
+		pending = chunk[:n]
+
+		if errors.Is(readErr, io.EOF) {
 			break
 		}
-		if err != nil {
-			return fmt.Errorf("read chunk of file content: %w", err)
+	}
+
+	if len(pending) > 0 {
+		if err = sendFileContentChunk(stream, limiter, pending); err != nil {
+			return filesStatusError(stream.Context(), err)
 		}
+	}
 
-		err = stream.Send(&files.DownloadFileResponse{
-			Data: &files.DownloadFileResponse_FileContentChunk{
-				FileContentChunk: chunk[:n],
+	err = stream.Send(&files.DownloadFileResponse{
+		Data: &files.DownloadFileResponse_ContentDigest{
+			ContentDigest: &files.ContentDigest{
+				Sha256: fileContent.SHA256(),
+				Crc32C: fileContent.CRC32C(),
 			},
-		})
-		if err != nil {
-			return fmt.Errorf("send chunk of file content to stream: %w", err)
-		}
+		},
+	})
+	if err != nil {
+		return filesStatusError(stream.Context(), fmt.Errorf("send digest of file content to stream: %w", err))
+	}
+
+	return nil
+}
+
+func sendFileContentChunk(stream files.FilesService_DownloadFileServer, limiter *BandwidthLimiter, chunk []byte) error {
+	if err := limiter.Wait(stream.Context(), len(chunk)); err != nil {
+		return fmt.Errorf("wait for bandwidth of stream: %w", err)
+	}
+
+	err := stream.Send(&files.DownloadFileResponse{
+		Data: &files.DownloadFileResponse_FileContentChunk{
+			FileContentChunk: chunk,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send chunk of file content to stream: %w", err)
 	}
 
 	return nil
 }
 
+func (s *FilesServiceServer) GetFileHeader(ctx context.Context, req *files.GetFileHeaderRequest) (*files.GetFileHeaderResponse, error) {
+	fileHeader, err := s.service.GetFileHeader(ctx, req.GetName())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.GetFileHeaderResponse{
+		FileHeader: newFileHeaderProto(fileHeader),
+	}, nil
+}
+
+func (s *FilesServiceServer) DeleteFile(ctx context.Context, req *files.DeleteFileRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteFile(ctx, req.GetName())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *FilesServiceServer) RenameFile(ctx context.Context, req *files.RenameFileRequest) (*files.RenameFileResponse, error) {
+	fileHeader, err := s.service.RenameFile(ctx, req.GetName(), req.GetNewName())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.RenameFileResponse{
+		FileHeader: newFileHeaderProto(fileHeader),
+	}, nil
+}
+
+func (s *FilesServiceServer) CopyFile(ctx context.Context, req *files.CopyFileRequest) (*files.CopyFileResponse, error) {
+	fileHeader, err := s.service.CopyFile(withUploader(ctx), req.GetName(), req.GetNewName())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.CopyFileResponse{
+		FileHeader: newFileHeaderProto(fileHeader),
+	}, nil
+}
+
+func (s *FilesServiceServer) GetFileACL(ctx context.Context, req *files.GetFileACLRequest) (*files.GetFileACLResponse, error) {
+	acl, err := s.service.GetFileACL(ctx, req.GetName())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.GetFileACLResponse{
+		Acl: newFileACLProto(acl),
+	}, nil
+}
+
+func (s *FilesServiceServer) SetFileACL(ctx context.Context, req *files.SetFileACLRequest) (*files.SetFileACLResponse, error) {
+	acl, err := s.service.SetFileACL(ctx, req.GetName(), FileACL{
+		Owner:   req.GetAcl().GetOwner(),
+		Readers: req.GetAcl().GetReaders(),
+		Writers: req.GetAcl().GetWriters(),
+	})
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.SetFileACLResponse{
+		Acl: newFileACLProto(acl),
+	}, nil
+}
+
+func (s *FilesServiceServer) CreateSignedURL(ctx context.Context, req *files.CreateSignedURLRequest) (*files.CreateSignedURLResponse, error) {
+	signedURL, expiresAt, err := s.service.CreateSignedURL(ctx, req.GetName(), req.GetMethod(), req.GetExpiresIn().AsDuration())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.CreateSignedURLResponse{
+		Url:       signedURL,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *FilesServiceServer) GetUsage(ctx context.Context, _ *files.GetUsageRequest) (*files.GetUsageResponse, error) {
+	report := s.service.GetUsage(ctx)
+
+	resp := files.GetUsageResponse{
+		Total:       newStorageUsageProto(report.Total),
+		MaxFileSize: report.MaxFileSize,
+	}
+	if report.Principal != nil {
+		resp.Principal = newStorageUsageProto(*report.Principal)
+	}
+
+	return &resp, nil
+}
+
+func (s *FilesServiceServer) CreateFolder(ctx context.Context, req *files.CreateFolderRequest) (*files.CreateFolderResponse, error) {
+	folderHeader, err := s.service.CreateFolder(ctx, req.GetName())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &files.CreateFolderResponse{
+		FolderHeader: newFileHeaderProto(folderHeader),
+	}, nil
+}
+
+func (s *FilesServiceServer) DeleteFolder(ctx context.Context, req *files.DeleteFolderRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteFolder(ctx, req.GetName(), req.GetRecursive())
+	if err != nil {
+		return nil, filesStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func newFileChecksums(info *files.UploadFileRequest_Info) FileChecksums {
+	checksums := FileChecksums{
+		SHA256: info.GetSha256(),
+	}
+	if info.GetCrc32C() != nil {
+		crc32c := info.GetCrc32C().GetValue()
+		checksums.CRC32C = &crc32c
+	}
+
+	return checksums
+}
+
+// withUploader marks who writes file by authenticated principal, or by peer address if authentication is disabled.
+func withUploader(ctx context.Context) context.Context {
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		return ContextWithUploader(ctx, principal.ID)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	return ContextWithUploader(ctx, host)
+}
+
+func newFileHeaderProto(h *FileHeader) *files.FileHeader {
+	fileHeader := files.FileHeader{
+		Name:        h.Name,
+		ContentType: h.ContentType,
+		Size:        h.Size,
+		Sha256:      h.SHA256,
+		IsFolder:    h.IsFolder,
+		Etag:        h.ETag,
+	}
+
+	if !h.CreatedAt.IsZero() {
+		fileHeader.CreatedAt = timestamppb.New(h.CreatedAt)
+	}
+	if !h.UpdatedAt.IsZero() {
+		fileHeader.UpdatedAt = timestamppb.New(h.UpdatedAt)
+	}
+
+	return &fileHeader
+}
+
+func newFileACLProto(acl *FileACL) *files.FileACL {
+	return &files.FileACL{
+		Owner:   acl.Owner,
+		Readers: acl.Readers,
+		Writers: acl.Writers,
+	}
+}
+
+func newStorageUsageProto(usage StorageUsage) *files.StorageUsage {
+	return &files.StorageUsage{
+		Bytes:    usage.Bytes,
+		Files:    usage.Files,
+		MaxBytes: usage.MaxBytes,
+		MaxFiles: usage.MaxFiles,
+	}
+}
+
 type FileContentReader struct {
 	stream files.FilesService_UploadFileServer
+	// offset is position in file of next received byte.
+	offset uint64
+	// size is expected size of file, zero if it is unknown.
+	size    uint64
+	pending []byte
+	// limiter caps bandwidth of stream, it is nil if bandwidth is not limited.
+	limiter *BandwidthLimiter
 }
 
-func NewFileContentReader(stream files.FilesService_UploadFileServer) *FileContentReader {
+func NewFileContentReader(stream files.FilesService_UploadFileServer, offset, size uint64, limiter *BandwidthLimiter) *FileContentReader {
 	return &FileContentReader{
-		stream: stream,
+		stream:  stream,
+		offset:  offset,
+		size:    size,
+		limiter: limiter,
 	}
 }
 
 func (r *FileContentReader) Read(dst []byte) (int, error) {
+	// Empty chunks are skipped, so reader does not return zero bytes without error.
+	for len(r.pending) == 0 {
+		if r.size > 0 && r.offset == r.size {
+			return 0, io.EOF
+		}
+
+		if err := r.receiveChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(dst, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
+
+func (r *FileContentReader) receiveChunk() error {
 	msg, err := r.stream.Recv()
 	if errors.Is(err, io.EOF) {
-		return 0, err
+		return err
 	}
 	if err != nil {
-		return 0, fmt.Errorf("read next file chunk: %w", err)
+		return fmt.Errorf("read next file chunk: %w", err)
 	}
 
-	chunk := msg.GetFileContentChunk()
-	n := copy(dst, chunk)
+	var chunk []byte
 
-	return n, nil
+	switch data := msg.GetData().(type) {
+	case *files.UploadFileRequest_FileContentChunk:
+		chunk = data.FileContentChunk
+	case *files.UploadFileRequest_FileChunk:
+		if data.FileChunk.GetOffset() != r.offset {
+			return fmt.Errorf("%w: chunk offset %d, expected %d", ErrInvalidUploadOffset, data.FileChunk.GetOffset(), r.offset)
+		}
+		chunk = data.FileChunk.GetContent()
+	default:
+		return fmt.Errorf("%w: unexpected message %T in file content", ErrInvalidArgument, data)
+	}
+
+	if r.size > 0 && r.offset+uint64(len(chunk)) > r.size {
+		return fmt.Errorf("%w: chunk ends after expected file size %d", ErrInvalidUploadOffset, r.size)
+	}
+
+	// Next chunk is received after chunk is allowed, so client is slowed down by flow control of stream.
+	if err := r.limiter.Wait(r.stream.Context(), len(chunk)); err != nil {
+		return fmt.Errorf("wait for bandwidth of stream: %w", err)
+	}
+
+	r.offset += uint64(len(chunk))
+	r.pending = chunk
+
+	return nil
 }
@@ -0,0 +1,614 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testUploadBufferSize  = 64
+	testDownloadChunkSize = 5
+)
+
+// testServer is files service served over in-memory connection like by main, without authentication.
+type testServer struct {
+	filesSystem *MemoryFileSystem
+	client      files.FilesServiceClient
+	// httpURL is URL of REST gateway of generated handlers.
+	httpURL string
+}
+
+func newTestServer(t *testing.T, limits StorageLimits) *testServer {
+	t.Helper()
+	return newAuthTestServer(t, limits, nil)
+}
+
+// newAuthTestServer makes server like main does, if auth interceptor is not nil calls are authenticated
+// and principals work with files of own folders.
+func newAuthTestServer(t *testing.T, limits StorageLimits, authInterceptor *AuthInterceptor) *testServer {
+	t.Helper()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	memoryFilesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	quota, err := NewQuotaFilesSystem(ctx, memoryFilesSystem, limits, authInterceptor != nil)
+	if err != nil {
+		t.Fatalf("new quota files system: %v", err)
+	}
+
+	var (
+		filesSystem   FilesSystem        = quota
+		metadataStore FilesMetadataStore = MustNewJSONFilesMetadataStore(filepath.Join(dir, "metadata.json"))
+
+		unaryInterceptors  = []grpc.UnaryServerInterceptor{ValidationUnaryInterceptor}
+		streamInterceptors = []grpc.StreamServerInterceptor{ValidationStreamInterceptor}
+	)
+	if authInterceptor != nil {
+		filesSystem = NewNamespacedFilesSystem(filesSystem)
+		metadataStore = NewNamespacedFilesMetadataStore(metadataStore)
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{authInterceptor.Unary}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{authInterceptor.Stream}, streamInterceptors...)
+	}
+
+	service := NewFilesService(
+		filesSystem,
+		metadataStore,
+		NewContentTypeResolver(nil),
+		MustNewUploadStaging(filepath.Join(dir, "uploads")),
+		quota,
+		nil,
+	)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	NewFilesServiceServer(service, testUploadBufferSize, testDownloadChunkSize, 0).RegistrationGRPC(server)
+
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	client := files.NewFilesServiceClient(conn)
+
+	mux := gwruntime.NewServeMux()
+	if err = files.RegisterFilesServiceHandlerClient(ctx, mux, client); err != nil {
+		t.Fatalf("register gateway handlers: %v", err)
+	}
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+
+	return &testServer{
+		filesSystem: memoryFilesSystem,
+		client:      client,
+		httpURL:     httpServer.URL,
+	}
+}
+
+// upload sends info and then content by chunks of passed size.
+func (s *testServer) upload(ctx context.Context, info *files.UploadFileRequest_Info, content string, chunkSize int) (*files.UploadFileResponse, error) {
+	stream, err := s.client.UploadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&files.UploadFileRequest{Data: &files.UploadFileRequest_FileInfo{FileInfo: info}})
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	for len(content) > 0 && err == nil {
+		n := chunkSize
+		if n > len(content) {
+			n = len(content)
+		}
+		err = stream.Send(&files.UploadFileRequest{
+			Data: &files.UploadFileRequest_FileContentChunk{FileContentChunk: []byte(content[:n])},
+		})
+		content = content[n:]
+	}
+	// Server closed stream with error, it is received by CloseAndRecv.
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (s *testServer) mustUpload(t *testing.T, name, content string) *files.FileHeader {
+	t.Helper()
+
+	resp, err := s.upload(context.Background(), &files.UploadFileRequest_Info{Name: name}, content, 7)
+	if err != nil {
+		t.Fatalf("upload %s: %v", name, err)
+	}
+	return resp.GetFileHeader()
+}
+
+// download returns header, content and digest of stream.
+func (s *testServer) download(ctx context.Context, name string, offset int64, length uint64) (*files.FileHeader, string, *files.ContentDigest, error) {
+	stream, err := s.client.DownloadFile(ctx, &files.DownloadFileRequest{Name: name, Offset: offset, Length: length})
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	var (
+		header  *files.FileHeader
+		content bytes.Buffer
+		digest  *files.ContentDigest
+	)
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, "", nil, err
+		}
+
+		switch {
+		case msg.GetFileHeader() != nil:
+			header = msg.GetFileHeader()
+		case msg.GetContentDigest() != nil:
+			digest = msg.GetContentDigest()
+		default:
+			content.Write(msg.GetFileContentChunk())
+		}
+	}
+
+	return header, content.String(), digest, nil
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestFilesServiceServer_UploadAndDownload(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, StorageLimits{})
+
+	const content = "content of file, which is longer than chunks of upload and download"
+
+	resp, err := server.upload(ctx, &files.UploadFileRequest_Info{
+		Name:   "folder/file.txt",
+		Size:   uint64(len(content)),
+		Sha256: sha256Hex(content),
+	}, content, 7)
+	if err != nil {
+		t.Fatalf("upload file: %v", err)
+	}
+	if resp.GetUploadId() == "" || resp.GetCommittedOffset() != uint64(len(content)) {
+		t.Fatalf("unexpected upload response %v", resp)
+	}
+	if h := resp.GetFileHeader(); h.GetName() != "folder/file.txt" || h.GetSize() != uint64(len(content)) || h.GetContentType() != "text/plain; charset=utf-8" {
+		t.Fatalf("unexpected file header %v", h)
+	}
+
+	tests := []struct {
+		name    string
+		offset  int64
+		length  uint64
+		content string
+	}{
+		{name: "whole", content: content},
+		{name: "range", offset: 8, length: 4, content: content[8:12]},
+		{name: "to end", offset: 8, content: content[8:]},
+		{name: "suffix", offset: -4, content: content[len(content)-4:]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, downloaded, digest, err := server.download(ctx, "folder/file.txt", tt.offset, tt.length)
+			if err != nil {
+				t.Fatalf("download file: %v", err)
+			}
+			if header.GetSize() != uint64(len(content)) {
+				t.Fatalf("expected size %d of file in header, got %d", len(content), header.GetSize())
+			}
+			if downloaded != tt.content {
+				t.Fatalf("expected content %q, got %q", tt.content, downloaded)
+			}
+			if digest.GetSha256() != sha256Hex(tt.content) {
+				t.Fatalf("expected sha256 %s, got %s", sha256Hex(tt.content), digest.GetSha256())
+			}
+		})
+	}
+}
+
+func TestFilesServiceServer_UploadErrors(t *testing.T) {
+	const content = "content of file"
+	crc32c := uint32(1)
+
+	tests := []struct {
+		name   string
+		limits StorageLimits
+		faults MemoryFileSystemFaults
+		info   *files.UploadFileRequest_Info
+		code   codes.Code
+	}{
+		{
+			name: "sha256 mismatch",
+			info: &files.UploadFileRequest_Info{Name: "file.txt", Sha256: sha256Hex("other content")},
+			code: codes.DataLoss,
+		},
+		{
+			name: "crc32c mismatch",
+			info: &files.UploadFileRequest_Info{Name: "file.txt", Crc32C: wrapperspb.UInt32(crc32c)},
+			code: codes.DataLoss,
+		},
+		{
+			name: "if-match of other etag",
+			info: &files.UploadFileRequest_Info{Name: "existing.txt", IfMatch: []string{"other"}},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "if-match of not existing file",
+			info: &files.UploadFileRequest_Info{Name: "file.txt", IfMatch: []string{"*"}},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:   "file larger than max size",
+			limits: StorageLimits{MaxFileSize: uint64(len(content)) - 1},
+			info:   &files.UploadFileRequest_Info{Name: "file.txt"},
+			code:   codes.ResourceExhausted,
+		},
+		{
+			name:   "storage is full",
+			limits: StorageLimits{MaxBytes: uint64(len("existing")) + 4},
+			info:   &files.UploadFileRequest_Info{Name: "file.txt"},
+			code:   codes.ResourceExhausted,
+		},
+		{
+			name: "invalid name",
+			info: &files.UploadFileRequest_Info{Name: "../file.txt"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:   "fault of files system",
+			faults: MemoryFileSystemFaults{FailSaveAfter: 4},
+			info:   &files.UploadFileRequest_Info{Name: "file.txt"},
+			code:   codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := newTestServer(t, tt.limits)
+			server.mustUpload(t, "existing.txt", "existing")
+			server.filesSystem.SetFaults(tt.faults)
+
+			_, err := server.upload(ctx, tt.info, content, 4)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("expected code %s, got %v", tt.code, err)
+			}
+
+			// Failed upload of valid name does not leave file.
+			if tt.code != codes.InvalidArgument && tt.info.GetName() != "existing.txt" {
+				_, err = server.client.GetFileHeader(ctx, &files.GetFileHeaderRequest{Name: tt.info.GetName()})
+				if status.Code(err) != codes.NotFound {
+					t.Fatalf("expected not found file after failed upload, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestFilesServiceServer_UploadIfMatch(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, StorageLimits{})
+
+	header := server.mustUpload(t, "file.txt", "first")
+
+	resp, err := server.upload(ctx, &files.UploadFileRequest_Info{Name: "file.txt", IfMatch: []string{header.GetEtag()}}, "second", 4)
+	if err != nil {
+		t.Fatalf("upload with etag of current file: %v", err)
+	}
+
+	// Etag of replaced file is stale.
+	_, err = server.upload(ctx, &files.UploadFileRequest_Info{Name: "file.txt", IfMatch: []string{header.GetEtag()}}, "third", 4)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition of stale etag, got %v", err)
+	}
+
+	_, content, _, err := server.download(ctx, "file.txt", 0, 0)
+	if err != nil || content != "second" {
+		t.Fatalf("expected content of second upload, got %q and error %v", content, err)
+	}
+	if resp.GetFileHeader().GetEtag() == header.GetEtag() {
+		t.Fatalf("etag is not changed by upload")
+	}
+}
+
+func TestFilesServiceServer_UploadFirstMessageIsNotInfo(t *testing.T) {
+	server := newTestServer(t, StorageLimits{})
+
+	stream, err := server.client.UploadFile(context.Background())
+	if err != nil {
+		t.Fatalf("start upload: %v", err)
+	}
+	err = stream.Send(&files.UploadFileRequest{Data: &files.UploadFileRequest_FileContentChunk{FileContentChunk: []byte("content")}})
+	if err != nil {
+		t.Fatalf("send chunk: %v", err)
+	}
+
+	_, err = stream.CloseAndRecv()
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = badRequest.GetFieldViolations()
+		}
+	}
+	if len(violations) != 1 || violations[0].GetField() != "file_info" {
+		t.Fatalf("expected violation of field file_info, got %v", violations)
+	}
+}
+
+func TestFilesServiceServer_RenameCopyDelete(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, StorageLimits{})
+	server.mustUpload(t, "a.txt", "content")
+
+	if _, err := server.client.RenameFile(ctx, &files.RenameFileRequest{Name: "a.txt", NewName: "folder/b.txt"}); err != nil {
+		t.Fatalf("rename file: %v", err)
+	}
+	if _, err := server.client.CopyFile(ctx, &files.CopyFileRequest{Name: "folder/b.txt", NewName: "c.txt"}); err != nil {
+		t.Fatalf("copy file: %v", err)
+	}
+	if _, err := server.client.DeleteFile(ctx, &files.DeleteFileRequest{Name: "folder/b.txt"}); err != nil {
+		t.Fatalf("delete file: %v", err)
+	}
+
+	for name, code := range map[string]codes.Code{"a.txt": codes.NotFound, "folder/b.txt": codes.NotFound, "c.txt": codes.OK} {
+		if _, err := server.client.GetFileHeader(ctx, &files.GetFileHeaderRequest{Name: name}); status.Code(err) != code {
+			t.Fatalf("expected code %s of %s, got %v", code, name, err)
+		}
+	}
+
+	_, content, _, err := server.download(ctx, "c.txt", 0, 0)
+	if err != nil || content != "content" {
+		t.Fatalf("expected content of copy, got %q and error %v", content, err)
+	}
+
+	if _, err = server.client.RenameFile(ctx, &files.RenameFileRequest{Name: "a.txt", NewName: "d.txt"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound of rename of deleted file, got %v", err)
+	}
+	if _, _, _, err = server.download(ctx, "folder/b.txt", 0, 0); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound of download of deleted file, got %v", err)
+	}
+}
+
+func TestFilesServiceServer_ListFilesHeader(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, StorageLimits{})
+	server.mustUpload(t, "a.txt", "a")
+	server.mustUpload(t, "b.txt", "bb")
+	server.mustUpload(t, "folder/c.txt", "ccc")
+
+	resp, err := server.client.ListFilesHeader(ctx, &files.ListFilesHeaderRequest{})
+	if err != nil {
+		t.Fatalf("list files: %v", err)
+	}
+
+	names := make([]string, len(resp.GetItems()))
+	for i, item := range resp.GetItems() {
+		names[i] = item.GetName()
+	}
+	if got := strings.Join(names, ","); got != "a.txt,b.txt,folder" {
+		t.Fatalf("unexpected files %s", got)
+	}
+
+	resp, err = server.client.ListFilesHeader(ctx, &files.ListFilesHeaderRequest{Parent: "folder"})
+	if err != nil {
+		t.Fatalf("list files of folder: %v", err)
+	}
+	if len(resp.GetItems()) != 1 || resp.GetItems()[0].GetName() != "folder/c.txt" {
+		t.Fatalf("unexpected files of folder %v", resp.GetItems())
+	}
+}
+
+func TestFilesServiceServer_HTTP(t *testing.T) {
+	server := newTestServer(t, StorageLimits{})
+	server.mustUpload(t, "folder/file.txt", "content")
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		statusCode int
+	}{
+		{name: "header", method: http.MethodGet, path: "/v1/files/folder/file.txt/header", statusCode: http.StatusOK},
+		{name: "header of not existing file", method: http.MethodGet, path: "/v1/files/other.txt/header", statusCode: http.StatusNotFound},
+		{name: "list", method: http.MethodGet, path: "/v1/files", statusCode: http.StatusOK},
+		{name: "list of not existing folder", method: http.MethodGet, path: "/v1/files?parent=other", statusCode: http.StatusNotFound},
+		{name: "list of invalid folder", method: http.MethodGet, path: "/v1/files?parent=..", statusCode: http.StatusBadRequest},
+		{name: "create folder", method: http.MethodPost, path: "/v1/folders", body: `{"name":"new"}`, statusCode: http.StatusOK},
+		{name: "delete not empty folder", method: http.MethodDelete, path: "/v1/folders/folder", statusCode: http.StatusBadRequest},
+		{name: "delete file", method: http.MethodDelete, path: "/v1/files/folder/file.txt", statusCode: http.StatusOK},
+		{name: "delete deleted file", method: http.MethodDelete, path: "/v1/files/folder/file.txt", statusCode: http.StatusNotFound},
+		{name: "signed url is disabled", method: http.MethodPost, path: "/v1/files/folder/file.txt:signedUrl", body: `{"method":"GET"}`, statusCode: http.StatusNotImplemented},
+	}
+
+	// Cases are run in order, so later cases see changes of earlier ones.
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, server.httpURL+tt.path, strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatalf("%s: new request: %v", tt.name, err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s: do request: %v", tt.name, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != tt.statusCode {
+			t.Fatalf("%s: expected status %d, got %d: %s", tt.name, tt.statusCode, resp.StatusCode, body)
+		}
+	}
+}
+
+// uploadMessages sends info and then passed messages of content.
+func (s *testServer) uploadMessages(ctx context.Context, info *files.UploadFileRequest_Info, msgs ...*files.UploadFileRequest) (*files.UploadFileResponse, error) {
+	stream, err := s.client.UploadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs = append([]*files.UploadFileRequest{{Data: &files.UploadFileRequest_FileInfo{FileInfo: info}}}, msgs...)
+	for _, msg := range msgs {
+		if err = stream.Send(msg); errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func contentChunk(content string) *files.UploadFileRequest {
+	return &files.UploadFileRequest{Data: &files.UploadFileRequest_FileContentChunk{FileContentChunk: []byte(content)}}
+}
+
+func offsetChunk(offset uint64, content string) *files.UploadFileRequest {
+	return &files.UploadFileRequest{Data: &files.UploadFileRequest_FileChunk{FileChunk: &files.UploadFileRequest_Chunk{Offset: offset, Content: []byte(content)}}}
+}
+
+func TestFilesServiceServer_ResumeUpload(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, StorageLimits{})
+
+	const content = "0123456789"
+
+	// Stream is ended before whole file is received, so upload is not completed.
+	resp, err := server.uploadMessages(ctx, &files.UploadFileRequest_Info{Name: "file.txt", Size: uint64(len(content))}, contentChunk(content[:4]))
+	if err != nil {
+		t.Fatalf("start upload: %v", err)
+	}
+	if resp.GetFileHeader() != nil || resp.GetCommittedOffset() != 4 {
+		t.Fatalf("expected not completed upload at offset 4, got %v", resp)
+	}
+	uploadID := resp.GetUploadId()
+
+	uploadStatus, err := server.client.GetUploadStatus(ctx, &files.GetUploadStatusRequest{UploadId: uploadID})
+	if err != nil {
+		t.Fatalf("get upload status: %v", err)
+	}
+	if uploadStatus.GetName() != "file.txt" || uploadStatus.GetCommittedOffset() != 4 || uploadStatus.GetSize() != uint64(len(content)) {
+		t.Fatalf("unexpected upload status %v", uploadStatus)
+	}
+
+	// Chunk of other offset is rejected and nothing is appended.
+	_, err = server.uploadMessages(ctx, &files.UploadFileRequest_Info{UploadId: uploadID}, offsetChunk(2, content[2:]))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition of invalid offset, got %v", err)
+	}
+	_, err = server.uploadMessages(ctx, &files.UploadFileRequest_Info{UploadId: uploadID, Name: "other.txt"}, offsetChunk(4, content[4:]))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument of other name, got %v", err)
+	}
+
+	// Empty chunks are skipped.
+	resp, err = server.uploadMessages(ctx, &files.UploadFileRequest_Info{UploadId: uploadID},
+		contentChunk(""), offsetChunk(4, content[4:7]), contentChunk(""), contentChunk(""), offsetChunk(7, content[7:]))
+	if err != nil {
+		t.Fatalf("resume upload: %v", err)
+	}
+	if resp.GetFileHeader().GetSize() != uint64(len(content)) || resp.GetCommittedOffset() != uint64(len(content)) {
+		t.Fatalf("expected completed upload, got %v", resp)
+	}
+
+	_, downloaded, _, err := server.download(ctx, "file.txt", 0, 0)
+	if err != nil || downloaded != content {
+		t.Fatalf("expected content %q, got %q and error %v", content, downloaded, err)
+	}
+
+	// Session of completed upload is deleted.
+	_, err = server.client.GetUploadStatus(ctx, &files.GetUploadStatusRequest{UploadId: uploadID})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound of completed upload, got %v", err)
+	}
+}
+
+// fakeUploadStream returns passed messages and then EOF.
+type fakeUploadStream struct {
+	files.FilesService_UploadFileServer
+	msgs []*files.UploadFileRequest
+}
+
+func (s *fakeUploadStream) Recv() (*files.UploadFileRequest, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *fakeUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestFileContentReader_EmptyChunks(t *testing.T) {
+	// More empty chunks than bufio.Reader tolerates before io.ErrNoProgress.
+	msgs := make([]*files.UploadFileRequest, 0, 300)
+	for i := 0; i < 150; i++ {
+		msgs = append(msgs, contentChunk(""))
+	}
+	msgs = append(msgs, contentChunk("content"))
+	for i := 0; i < 150; i++ {
+		msgs = append(msgs, contentChunk(""))
+	}
+
+	reader := bufio.NewReaderSize(NewFileContentReader(&fakeUploadStream{msgs: msgs}, 0, 0, NewBandwidthLimiter(0)), 16)
+
+	// ReadBytes fills buffer from stream, it fails on many reads without progress.
+	content, err := reader.ReadBytes(0)
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("read content: %v", err)
+	}
+	if string(content) != "content" {
+		t.Fatalf("expected content %q, got %q", "content", content)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorKind is code of status and reason of ErrorInfo of kind of errors of files service.
+type errorKind struct {
+	err    error
+	code   codes.Code
+	reason string
+	// detailed kinds are sent with message of whole error, other kinds are sent with message of kind,
+	// so names of internal operations do not leak to client.
+	detailed bool
+}
+
+// errorKinds are checked in order, so special kinds are before general ones.
+var errorKinds = []errorKind{
+	{err: ErrFileNotFound, code: codes.NotFound, reason: "FILE_NOT_FOUND"},
+	{err: ErrFolderNotFound, code: codes.NotFound, reason: "FOLDER_NOT_FOUND"},
+	{err: ErrUploadNotFound, code: codes.NotFound, reason: "UPLOAD_NOT_FOUND"},
+	{err: ErrFileAlreadyExists, code: codes.AlreadyExists, reason: "FILE_ALREADY_EXISTS"},
+	{err: ErrInvalidName, code: codes.InvalidArgument, reason: "INVALID_NAME", detailed: true},
+	{err: ErrInvalidUploadOffset, code: codes.FailedPrecondition, reason: "INVALID_UPLOAD_OFFSET", detailed: true},
+	{err: ErrInvalidArgument, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT", detailed: true},
+	{err: ErrChecksumMismatch, code: codes.DataLoss, reason: "CHECKSUM_MISMATCH", detailed: true},
+	{err: ErrQuotaExceeded, code: codes.ResourceExhausted, reason: "QUOTA_EXCEEDED", detailed: true},
+	{err: ErrCapacityExceeded, code: codes.ResourceExhausted, reason: "CAPACITY_EXCEEDED", detailed: true},
+	{err: ErrFolderNotEmpty, code: codes.FailedPrecondition, reason: "FOLDER_NOT_EMPTY"},
+	{err: ErrPreconditionFailed, code: codes.FailedPrecondition, reason: "PRECONDITION_FAILED", detailed: true},
+	{err: ErrUploadInProgress, code: codes.Aborted, reason: "UPLOAD_IN_PROGRESS"},
+	{err: ErrPermissionDenied, code: codes.PermissionDenied, reason: "PERMISSION_DENIED", detailed: true},
+	{err: ErrSignedURLsDisabled, code: codes.Unimplemented, reason: "SIGNED_URLS_DISABLED"},
+	{err: context.Canceled, code: codes.Canceled, reason: "CANCELED"},
+	{err: context.DeadlineExceeded, code: codes.DeadlineExceeded, reason: "DEADLINE_EXCEEDED"},
+}
+
+// filesStatusError translates error of files service to status error with details: ErrorInfo with reason,
+// ResourceInfo of file, folder or upload, BadRequest of invalid field, QuotaFailure and PreconditionFailure.
+// Status errors are returned as is. Unknown errors are logged and returned as internal error without cause.
+func filesStatusError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &statusErr) {
+		return statusErr.GRPCStatus().Err()
+	}
+
+	kind, ok := findErrorKind(err)
+	if !ok {
+		logging.FromContext(ctx).WithError(err).Error("internal error")
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	msg := kind.err.Error()
+	if kind.detailed {
+		msg = err.Error()
+	}
+
+	// Error without resource and field has only ErrorInfo.
+	filesErr := &FilesError{}
+	errors.As(err, &filesErr)
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: kind.reason,
+		Domain: files.FilesService_ServiceDesc.ServiceName,
+	}
+	if filesErr.Resource != "" {
+		errorInfo.Metadata = map[string]string{filesErr.Resource: filesErr.Name}
+	}
+	details := []protoadapt.MessageV1{errorInfo}
+
+	if filesErr.Resource != "" {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: filesErr.Resource,
+			ResourceName: filesErr.Name,
+			Description:  kind.err.Error(),
+		})
+	}
+
+	switch kind.code {
+	case codes.InvalidArgument:
+		if filesErr.Field != "" {
+			details = append(details, &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: filesErr.Field, Description: err.Error()}},
+			})
+		}
+	case codes.ResourceExhausted:
+		details = append(details, &errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{Subject: filesErr.Name, Description: err.Error()}},
+		})
+	case codes.FailedPrecondition:
+		details = append(details, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: kind.reason, Subject: filesErr.Name, Description: err.Error()}},
+		})
+	}
+
+	st, detailsErr := status.New(kind.code, msg).WithDetails(details...)
+	if detailsErr != nil {
+		return status.Error(kind.code, msg)
+	}
+
+	return st.Err()
+}
+
+func findErrorKind(err error) (errorKind, bool) {
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind.err) {
+			return kind, true
+		}
+	}
+	return errorKind{}, false
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ FilesSystem = (*InstrumentedFilesSystem)(nil)
+
+const filesSystemTracerName = "github.com/EmptyShadow/go-examples/grpc-files/cmd/server"
+
+// InstrumentedFilesSystem records span of every call of files system and counts storage errors.
+// Errors of missing or existing files and folders, errors of saved content, like exceeded quota,
+// and cancelled calls are expected, they are not counted.
+type InstrumentedFilesSystem struct {
+	filesSystem FilesSystem
+	tracer      trace.Tracer
+	errors      *prometheus.CounterVec
+}
+
+func NewInstrumentedFilesSystem(filesSystem FilesSystem, registerer prometheus.Registerer) (*InstrumentedFilesSystem, error) {
+	s := InstrumentedFilesSystem{
+		filesSystem: filesSystem,
+		tracer:      otel.Tracer(filesSystemTracerName),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "files_storage_errors_total",
+			Help: "Count of failed calls of storage backend by operations.",
+		}, []string{"operation"}),
+	}
+
+	if err := registerer.Register(s.errors); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (s *InstrumentedFilesSystem) ListFilesInfo(ctx context.Context, parent string) (_ []FileInfo, err error) {
+	ctx, end := s.start(ctx, "ListFilesInfo", attribute.String("files.parent", parent))
+	defer func() { end(err) }()
+
+	return s.filesSystem.ListFilesInfo(ctx, parent)
+}
+
+func (s *InstrumentedFilesSystem) SaveFile(ctx context.Context, name string, content io.Reader) (size uint64, err error) {
+	ctx, end := s.start(ctx, "SaveFile", attribute.String("files.name", name))
+	defer func() { end(err, attribute.Int64("files.size", int64(size))) }()
+
+	return s.filesSystem.SaveFile(ctx, name, content)
+}
+
+func (s *InstrumentedFilesSystem) StatFile(ctx context.Context, name string) (_ FileInfo, err error) {
+	ctx, end := s.start(ctx, "StatFile", attribute.String("files.name", name))
+	defer func() { end(err) }()
+
+	return s.filesSystem.StatFile(ctx, name)
+}
+
+// ReadFile span ends when file is opened, reading of content is measured by span of call.
+func (s *InstrumentedFilesSystem) ReadFile(ctx context.Context, name string) (_ FileInfo, _ io.ReadSeekCloser, err error) {
+	ctx, end := s.start(ctx, "ReadFile", attribute.String("files.name", name))
+	defer func() { end(err) }()
+
+	return s.filesSystem.ReadFile(ctx, name)
+}
+
+func (s *InstrumentedFilesSystem) DeleteFile(ctx context.Context, name string) (err error) {
+	ctx, end := s.start(ctx, "DeleteFile", attribute.String("files.name", name))
+	defer func() { end(err) }()
+
+	return s.filesSystem.DeleteFile(ctx, name)
+}
+
+func (s *InstrumentedFilesSystem) RenameFile(ctx context.Context, name, newName string) (_ uint64, err error) {
+	ctx, end := s.start(ctx, "RenameFile", attribute.String("files.name", name), attribute.String("files.new_name", newName))
+	defer func() { end(err) }()
+
+	return s.filesSystem.RenameFile(ctx, name, newName)
+}
+
+func (s *InstrumentedFilesSystem) CopyFile(ctx context.Context, name, newName string) (_ uint64, err error) {
+	ctx, end := s.start(ctx, "CopyFile", attribute.String("files.name", name), attribute.String("files.new_name", newName))
+	defer func() { end(err) }()
+
+	return s.filesSystem.CopyFile(ctx, name, newName)
+}
+
+func (s *InstrumentedFilesSystem) CreateFolder(ctx context.Context, name string) (err error) {
+	ctx, end := s.start(ctx, "CreateFolder", attribute.String("files.name", name))
+	defer func() { end(err) }()
+
+	return s.filesSystem.CreateFolder(ctx, name)
+}
+
+func (s *InstrumentedFilesSystem) DeleteFolder(ctx context.Context, name string, recursive bool) (err error) {
+	ctx, end := s.start(ctx, "DeleteFolder", attribute.String("files.name", name), attribute.Bool("files.recursive", recursive))
+	defer func() { end(err) }()
+
+	return s.filesSystem.DeleteFolder(ctx, name, recursive)
+}
+
+// start starts span of operation, returned end records error and attributes known after call and ends span.
+func (s *InstrumentedFilesSystem) start(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, func(error, ...attribute.KeyValue)) {
+	ctx, span := s.tracer.Start(ctx, "FilesSystem."+operation, trace.WithAttributes(attrs...))
+
+	return ctx, func(err error, attrs ...attribute.KeyValue) {
+		span.SetAttributes(attrs...)
+		if err != nil {
+			span.RecordError(err)
+		}
+		if err != nil && !isExpectedStorageError(err) {
+			span.SetStatus(codes.Error, err.Error())
+			s.errors.WithLabelValues(operation).Inc()
+		}
+		span.End()
+	}
+}
+
+func isExpectedStorageError(err error) bool {
+	return errors.Is(err, ErrFileNotFound) ||
+		errors.Is(err, ErrFolderNotFound) ||
+		errors.Is(err, ErrFileAlreadyExists) ||
+		errors.Is(err, ErrFolderNotEmpty) ||
+		errors.Is(err, ErrInvalidArgument) ||
+		errors.Is(err, ErrQuotaExceeded) ||
+		errors.Is(err, ErrChecksumMismatch) ||
+		errors.Is(err, context.Canceled)
+}
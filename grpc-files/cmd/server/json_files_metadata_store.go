@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+var _ FilesMetadataStore = (*JSONFilesMetadataStore)(nil)
+
+// JSONFilesMetadataStore keeps metadata of all files in memory and dumps it to one json file on every change.
+// Every change rewrites whole file under lock, so cost of change grows with count of files and store
+// is meant for thousands of files, not millions.
+type JSONFilesMetadataStore struct {
+	mu       sync.RWMutex
+	filePath string
+	metadata map[string]FileMetadata
+}
+
+func MustNewJSONFilesMetadataStore(filePath string) *JSONFilesMetadataStore {
+	store, err := NewJSONFilesMetadataStore(filePath)
+	if err != nil {
+		panic(fmt.Errorf("fatal init json files metadata store: %w", err))
+	}
+	return store
+}
+
+func NewJSONFilesMetadataStore(filePath string) (*JSONFilesMetadataStore, error) {
+	store := JSONFilesMetadataStore{
+		filePath: filePath,
+		metadata: make(map[string]FileMetadata),
+	}
+
+	content, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return &store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read metadata file: %w", err)
+	}
+
+	if err = json.Unmarshal(content, &store.metadata); err != nil {
+		return nil, fmt.Errorf("unmarshal metadata file: %w", err)
+	}
+
+	return &store, nil
+}
+
+func (s *JSONFilesMetadataStore) GetFileMetadata(_ context.Context, name string) (*FileMetadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	metadata, ok := s.metadata[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return &metadata, nil
+}
+
+func (s *JSONFilesMetadataStore) SaveFileMetadata(_ context.Context, name string, metadata FileMetadata) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, existed := s.metadata[name]
+	s.metadata[name] = metadata
+
+	if err := s.dump(); err != nil {
+		if existed {
+			s.metadata[name] = prev
+		} else {
+			delete(s.metadata, name)
+		}
+		return fmt.Errorf("dump metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (s *JSONFilesMetadataStore) DeleteFileMetadata(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, existed := s.metadata[name]
+	if !existed {
+		return nil
+	}
+	delete(s.metadata, name)
+
+	if err := s.dump(); err != nil {
+		s.metadata[name] = prev
+		return fmt.Errorf("dump metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (s *JSONFilesMetadataStore) DeleteFolderMetadata(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := name + "/"
+	deleted := make(map[string]FileMetadata)
+
+	for fileName, metadata := range s.metadata {
+		if strings.HasPrefix(fileName, prefix) {
+			deleted[fileName] = metadata
+			delete(s.metadata, fileName)
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	if err := s.dump(); err != nil {
+		for fileName, metadata := range deleted {
+			s.metadata[fileName] = metadata
+		}
+		return fmt.Errorf("dump metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (s *JSONFilesMetadataStore) dump() (err error) {
+	content, err := json.Marshal(s.metadata)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	newFilePath := fmt.Sprintf("%s.new", s.filePath)
+
+	if err = os.MkdirAll(filepath.Dir(newFilePath), 0o755); err != nil {
+		return fmt.Errorf("create metadata dir: %w", err)
+	}
+
+	// New file is synced before rename, so crash does not replace old file by empty or truncated one.
+	if err = writeSyncedFile(newFilePath, content); err != nil {
+		os.Remove(newFilePath)
+		return fmt.Errorf("write new metadata file: %w", err)
+	}
+
+	if err = os.Rename(newFilePath, s.filePath); err != nil {
+		os.Remove(newFilePath)
+		return fmt.Errorf("change old metadata file to new: %w", err)
+	}
+
+	// Rename is durable only after directory is synced.
+	if err = syncDir(filepath.Dir(s.filePath)); err != nil {
+		return fmt.Errorf("sync metadata dir: %w", err)
+	}
+
+	return nil
+}
+
+func writeSyncedFile(filePath string, content []byte) error {
+	f, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var _ Authenticator = (*JWTAuthenticator)(nil)
+
+type JWTConfig struct {
+	// Secret is key of HMAC-SHA256 signature of tokens.
+	Secret []byte
+	// Issuer and Audience are checked if they are not empty.
+	Issuer   string
+	Audience string
+	// AllowNoExpiry accepts tokens without "exp" claim, else "exp" is required.
+	AllowNoExpiry bool
+}
+
+// JWTAuthenticator authenticates clients by JWT signed with HS256, subject of token is id of principal
+// and "roles" claim is list of its roles.
+type JWTAuthenticator struct {
+	config JWTConfig
+	now    func() time.Time
+}
+
+func NewJWTAuthenticator(config JWTConfig) (*JWTAuthenticator, error) {
+	if len(config.Secret) == 0 {
+		return nil, errors.New("empty jwt secret")
+	}
+
+	return &JWTAuthenticator{
+		config: config,
+		now:    time.Now,
+	}, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  jwtAudience     `json:"aud"`
+	ExpiresAt *jwtNumericDate `json:"exp"`
+	NotBefore *jwtNumericDate `json:"nbf"`
+	Roles     []string        `json:"roles"`
+}
+
+// jwtNumericDate is seconds since epoch, which may have fraction by RFC 7519.
+type jwtNumericDate float64
+
+func (d jwtNumericDate) Time() time.Time {
+	sec, frac := math.Modf(float64(d))
+	return time.Unix(int64(sec), int64(frac*float64(time.Second)))
+}
+
+// jwtAudience is one audience or list of audiences.
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(b []byte) error {
+	var one string
+	if err := json.Unmarshal(b, &one); err == nil {
+		*a = jwtAudience{one}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return fmt.Errorf("audience is not string or list of strings: %w", err)
+	}
+	*a = list
+
+	return nil
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, credentials string) (*Principal, error) {
+	parts := strings.Split(credentials, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: token is not jwt", ErrUnauthenticated)
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: decode header: %s", ErrUnauthenticated, err)
+	}
+	// Algorithm is fixed, so token can not choose "none" or other key type.
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrUnauthenticated, header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode signature: %s", ErrUnauthenticated, err)
+	}
+
+	mac := hmac.New(sha256.New, a.config.Secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("%w: invalid signature", ErrUnauthenticated)
+	}
+
+	var claims jwtClaims
+	if err = decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: decode claims: %s", ErrUnauthenticated, err)
+	}
+	if err = a.checkClaims(claims); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnauthenticated, err)
+	}
+
+	return &Principal{ID: claims.Subject, Roles: claims.Roles}, nil
+}
+
+func (a *JWTAuthenticator) checkClaims(claims jwtClaims) error {
+	now := a.now()
+
+	if claims.ExpiresAt == nil && !a.config.AllowNoExpiry {
+		return errors.New("token has no expiration time")
+	}
+	if claims.ExpiresAt != nil && !now.Before(claims.ExpiresAt.Time()) {
+		return errors.New("token is expired")
+	}
+	if claims.NotBefore != nil && now.Before(claims.NotBefore.Time()) {
+		return errors.New("token is not valid yet")
+	}
+	if a.config.Issuer != "" && claims.Issuer != a.config.Issuer {
+		return fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if a.config.Audience != "" && !claims.Audience.contains(a.config.Audience) {
+		return errors.New("token is not issued for this service")
+	}
+	if err := ValidatePrincipalID(claims.Subject); err != nil {
+		return fmt.Errorf("invalid subject: %w", err)
+	}
+
+	return nil
+}
+
+func (a jwtAudience) contains(audience string) bool {
+	for _, v := range a {
+		if v == audience {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	content, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+var testJWTSecret = []byte("secret")
+
+func signTestJWT(t *testing.T, secret []byte, header, claims string) string {
+	t.Helper()
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	const hs256 = `{"alg":"HS256","typ":"JWT"}`
+
+	// now is 1000.5 seconds since epoch.
+	now := time.Unix(1000, int64(500*time.Millisecond))
+
+	tests := []struct {
+		name      string
+		config    JWTConfig
+		token     string
+		principal *Principal
+	}{
+		{
+			name:      "valid",
+			token:     signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":2000,"roles":["admin"]}`),
+			principal: &Principal{ID: "alice", Roles: []string{"admin"}},
+		},
+		{
+			name:      "fractional exp after now",
+			token:     signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":1000.75}`),
+			principal: &Principal{ID: "alice"},
+		},
+		{
+			name:  "fractional exp before now",
+			token: signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":1000.25}`),
+		},
+		{
+			name:  "exp equal to now",
+			token: signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":1000.5}`),
+		},
+		{
+			name:      "fractional nbf before now",
+			token:     signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":2000,"nbf":1000.25}`),
+			principal: &Principal{ID: "alice"},
+		},
+		{
+			name:  "fractional nbf after now",
+			token: signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":2000,"nbf":1000.75}`),
+		},
+		{
+			name:  "no exp",
+			token: signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice"}`),
+		},
+		{
+			name:      "no exp allowed",
+			config:    JWTConfig{AllowNoExpiry: true},
+			token:     signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice"}`),
+			principal: &Principal{ID: "alice"},
+		},
+		{
+			name:      "issuer and audience list",
+			config:    JWTConfig{Issuer: "auth", Audience: "files"},
+			token:     signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":2000,"iss":"auth","aud":["other","files"]}`),
+			principal: &Principal{ID: "alice"},
+		},
+		{
+			name:   "unexpected issuer",
+			config: JWTConfig{Issuer: "auth"},
+			token:  signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":2000,"iss":"other"}`),
+		},
+		{
+			name:   "unexpected audience",
+			config: JWTConfig{Audience: "files"},
+			token:  signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice","exp":2000,"aud":"other"}`),
+		},
+		{
+			name:  "invalid subject",
+			token: signTestJWT(t, testJWTSecret, hs256, `{"sub":"alice/bob","exp":2000}`),
+		},
+		{
+			name:  "alg none",
+			token: signTestJWT(t, testJWTSecret, `{"alg":"none"}`, `{"sub":"alice","exp":2000}`),
+		},
+		{
+			name:  "other secret",
+			token: signTestJWT(t, []byte("other"), hs256, `{"sub":"alice","exp":2000}`),
+		},
+		{
+			name:  "not jwt",
+			token: "alice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Secret = testJWTSecret
+
+			authenticator, err := NewJWTAuthenticator(tt.config)
+			if err != nil {
+				t.Fatalf("new authenticator: %v", err)
+			}
+			authenticator.now = func() time.Time { return now }
+
+			principal, err := authenticator.Authenticate(context.Background(), tt.token)
+			if tt.principal == nil {
+				if !errors.Is(err, ErrUnauthenticated) {
+					t.Fatalf("expected ErrUnauthenticated, got principal %v and error %v", principal, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate: %v", err)
+			}
+			if principal.ID != tt.principal.ID || len(principal.Roles) != len(tt.principal.Roles) {
+				t.Fatalf("expected principal %v, got %v", tt.principal, principal)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// keyedLimitersSweepPeriod is period of dropping of limiters of keys, which are not used.
+const keyedLimitersSweepPeriod = time.Minute
+
+// KeyedRateLimiter is token bucket per key, like id of principal or address of client. Bucket of key is dropped
+// when it is refilled after last call, so limiters of gone clients do not pile up.
+type KeyedRateLimiter struct {
+	limit rate.Limit
+	burst int
+	// idle is time of refill of empty bucket.
+	idle time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*keyedLimiter
+	sweptAt  time.Time
+}
+
+type keyedLimiter struct {
+	limiter *rate.Limiter
+	usedAt  time.Time
+}
+
+// NewKeyedRateLimiter makes limiter of calls per second with burst, burst is at least one call.
+func NewKeyedRateLimiter(callsPerSecond float64, burst int) *KeyedRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &KeyedRateLimiter{
+		limit:    rate.Limit(callsPerSecond),
+		burst:    burst,
+		idle:     time.Duration(float64(burst) / callsPerSecond * float64(time.Second)),
+		limiters: make(map[string]*keyedLimiter),
+		sweptAt:  time.Now(),
+	}
+}
+
+// Allow takes token of key, it returns false and time after which token is available if bucket is empty.
+func (l *KeyedRateLimiter) Allow(key string) (bool, time.Duration) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.sweptAt) > keyedLimitersSweepPeriod {
+		l.sweep(now)
+	}
+
+	kl, ok := l.limiters[key]
+	if !ok {
+		kl = &keyedLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = kl
+	}
+	kl.usedAt = now
+
+	reservation := kl.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// Token is not taken, so rejected calls do not delay next ones.
+		reservation.CancelAt(now)
+		return false, delay
+	}
+
+	return true, 0
+}
+
+func (l *KeyedRateLimiter) sweep(now time.Time) {
+	for key, kl := range l.limiters {
+		if now.Sub(kl.usedAt) > l.idle {
+			delete(l.limiters, key)
+		}
+	}
+	l.sweptAt = now
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKeyedRateLimiter_Allow(t *testing.T) {
+	limiter := NewKeyedRateLimiter(10, 2)
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := limiter.Allow("alice"); !ok {
+			t.Fatalf("expected call %d in burst allowed", i)
+		}
+	}
+
+	ok, retryAfter := limiter.Allow("alice")
+	if ok {
+		t.Fatalf("expected call over burst rejected")
+	}
+	if retryAfter <= 0 || retryAfter > 100*time.Millisecond {
+		t.Fatalf("expected retry after token of 10 calls per second, got %s", retryAfter)
+	}
+
+	// Buckets of keys are independent.
+	if ok, _ = limiter.Allow("bob"); !ok {
+		t.Fatalf("expected call of other key allowed")
+	}
+
+	// Rejected calls do not take tokens, so token is available after first delay.
+	for i := 0; i < 3; i++ {
+		if ok, _ = limiter.Allow("alice"); ok {
+			t.Fatalf("expected rejected call %d", i)
+		}
+	}
+	time.Sleep(retryAfter)
+	if ok, _ = limiter.Allow("alice"); !ok {
+		t.Fatalf("expected call allowed after retry delay")
+	}
+}
+
+func TestKeyedRateLimiter_MinimalBurst(t *testing.T) {
+	limiter := NewKeyedRateLimiter(1, 0)
+
+	if ok, _ := limiter.Allow("alice"); !ok {
+		t.Fatalf("expected first call allowed")
+	}
+	if ok, retryAfter := limiter.Allow("alice"); ok || retryAfter <= 0 || retryAfter > time.Second {
+		t.Fatalf("expected second call rejected for at most second, got %t %s", ok, retryAfter)
+	}
+}
+
+func TestKeyedRateLimiter_Sweep(t *testing.T) {
+	limiter := NewKeyedRateLimiter(10, 2)
+	limiter.Allow("alice")
+	limiter.Allow("bob")
+
+	limiter.mu.Lock()
+	limiter.limiters["bob"].usedAt = time.Now().Add(-time.Second)
+	limiter.sweptAt = time.Now().Add(-2 * keyedLimitersSweepPeriod)
+	limiter.mu.Unlock()
+
+	// Bucket of bob is refilled, so it is dropped on next call.
+	limiter.Allow("carol")
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if _, ok := limiter.limiters["bob"]; ok {
+		t.Fatalf("expected idle limiter dropped")
+	}
+	if _, ok := limiter.limiters["alice"]; !ok {
+		t.Fatalf("expected used limiter kept")
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultListPageSize = 100
+	maxListPageSize     = 1000
+)
+
+const (
+	orderByName  = "name"
+	orderBySize  = "size"
+	orderByMtime = "mtime"
+)
+
+type ListFilesQuery struct {
+	// Parent is folder of listed files, empty for root folder.
+	Parent      string
+	PageSize    int
+	PageToken   string
+	NamePrefix  string
+	NamePattern string
+	OrderBy     string
+}
+
+type FilesHeaderPage struct {
+	Items         []FileHeader
+	NextPageToken string
+	TotalSize     int
+}
+
+// listFilesCursor is position after last item of page, so inserts and deletes between
+// requests do not shift next pages like offset does.
+type listFilesCursor struct {
+	Query     string `json:"q"`
+	Name      string `json:"n"`
+	Size      uint64 `json:"s,omitempty"`
+	MtimeNano int64  `json:"m,omitempty"`
+}
+
+type listFilesOrder struct {
+	field string
+	desc  bool
+}
+
+func parseListFilesOrder(orderBy string) (listFilesOrder, error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+
+	if len(fields) == 0 {
+		return listFilesOrder{field: orderByName}, nil
+	}
+	if len(fields) > 2 || (len(fields) == 2 && fields[1] != "desc" && fields[1] != "asc") {
+		return listFilesOrder{}, fieldError(fmt.Errorf("%w: order by %q", ErrInvalidArgument, orderBy), "order_by")
+	}
+
+	switch fields[0] {
+	case orderByName, orderBySize, orderByMtime:
+	default:
+		return listFilesOrder{}, fieldError(fmt.Errorf("%w: unknown order by field %q", ErrInvalidArgument, fields[0]), "order_by")
+	}
+
+	return listFilesOrder{
+		field: fields[0],
+		desc:  len(fields) == 2 && fields[1] == "desc",
+	}, nil
+}
+
+// less compares files by order field and by name for files with equal field values.
+func (o listFilesOrder) less(a, b listFilesCursor) bool {
+	var cmp int
+
+	switch o.field {
+	case orderBySize:
+		cmp = compareOrdered(a.Size, b.Size)
+	case orderByMtime:
+		cmp = compareOrdered(a.MtimeNano, b.MtimeNano)
+	}
+	if cmp == 0 {
+		cmp = strings.Compare(a.Name, b.Name)
+	}
+
+	if o.desc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+func compareOrdered[T uint64 | int64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (q ListFilesQuery) pageSize() (int, error) {
+	switch {
+	case q.PageSize < 0:
+		return 0, fieldError(fmt.Errorf("%w: negative page size", ErrInvalidArgument), "page_size")
+	case q.PageSize == 0:
+		return defaultListPageSize, nil
+	case q.PageSize > maxListPageSize:
+		return maxListPageSize, nil
+	default:
+		return q.PageSize, nil
+	}
+}
+
+// fingerprint binds page token to filters and order, page size may be changed between pages.
+func (q ListFilesQuery) fingerprint() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{q.Parent, q.NamePrefix, q.NamePattern, strings.ToLower(q.OrderBy)}, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// match checks name of file inside of parent folder, so prefix and pattern do not contain path of parent.
+func (q ListFilesQuery) match(name string) (bool, error) {
+	name = path.Base(name)
+
+	if !strings.HasPrefix(name, q.NamePrefix) {
+		return false, nil
+	}
+	if q.NamePattern == "" {
+		return true, nil
+	}
+
+	matched, err := path.Match(q.NamePattern, name)
+	if err != nil {
+		return false, fieldError(fmt.Errorf("%w: name pattern: %s", ErrInvalidArgument, err), "name_pattern")
+	}
+
+	return matched, nil
+}
+
+func decodeListFilesCursor(token string) (*listFilesCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	content, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fieldError(fmt.Errorf("%w: page token", ErrInvalidArgument), "page_token")
+	}
+
+	var cursor listFilesCursor
+	if err = json.Unmarshal(content, &cursor); err != nil {
+		return nil, fieldError(fmt.Errorf("%w: page token", ErrInvalidArgument), "page_token")
+	}
+
+	return &cursor, nil
+}
+
+func encodeListFilesCursor(cursor listFilesCursor) string {
+	content, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(content)
+}
+
+func newListFilesCursor(query string, info FileInfo) listFilesCursor {
+	return listFilesCursor{
+		Query:     query,
+		Name:      info.Name,
+		Size:      info.Size,
+		MtimeNano: info.ModifiedAt.UnixNano(),
+	}
+}
+
+// selectFilesPage filters and sorts files info, then cuts page after cursor.
+func selectFilesPage(filesInfo []FileInfo, q ListFilesQuery) (page []FileInfo, nextPageToken string, totalSize int, err error) {
+	pageSize, err := q.pageSize()
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	order, err := parseListFilesOrder(q.OrderBy)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	fingerprint := q.fingerprint()
+
+	cursor, err := decodeListFilesCursor(q.PageToken)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if cursor != nil && cursor.Query != fingerprint {
+		return nil, "", 0, fieldError(fmt.Errorf("%w: page token was issued for other filters or order", ErrInvalidArgument), "page_token")
+	}
+
+	matched := make([]FileInfo, 0, len(filesInfo))
+	for i := range filesInfo {
+		ok, err := q.match(filesInfo[i].Name)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		if ok {
+			matched = append(matched, filesInfo[i])
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return order.less(newListFilesCursor(fingerprint, matched[i]), newListFilesCursor(fingerprint, matched[j]))
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(matched), func(i int) bool {
+			return order.less(*cursor, newListFilesCursor(fingerprint, matched[i]))
+		})
+	}
+
+	end := start + pageSize
+	if end < len(matched) {
+		nextPageToken = encodeListFilesCursor(newListFilesCursor(fingerprint, matched[end-1]))
+	} else {
+		end = len(matched)
+	}
+
+	return matched[start:end], nextPageToken, len(matched), nil
+}
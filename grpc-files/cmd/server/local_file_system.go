@@ -7,11 +7,19 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"syscall"
 )
 
 var _ FilesSystem = (*LocalFileSystem)(nil)
 
+var (
+	errNotDirectory      = syscall.ENOTDIR
+	errDirectoryNotEmpty = syscall.ENOTEMPTY
+)
+
 type LocalFileSystem struct {
 	root string
 }
@@ -32,6 +40,11 @@ func NewLocalFileSystem(root string) (_ *LocalFileSystem, err error) {
 		}
 	}
 
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("get absolute path of root: %w", err)
+	}
+
 	lsf := LocalFileSystem{
 		root: root,
 	}
@@ -39,61 +52,359 @@ func NewLocalFileSystem(root string) (_ *LocalFileSystem, err error) {
 	return &lsf, nil
 }
 
-func (s *LocalFileSystem) ListFilesInfo(ctx context.Context) ([]FileInfo, error) {
-	osFilesInfo, err := ioutil.ReadDir(s.root)
+func (s *LocalFileSystem) Root() string {
+	return s.root
+}
+
+func (s *LocalFileSystem) path(name string) (string, error) {
+	return pathInRoot(s.root, name)
+}
+
+// pathInRoot converts slash separated name to os path and checks that it is inside of root.
+func pathInRoot(root, name string) (string, error) {
+	osPath := filepath.Join(root, filepath.FromSlash(name))
+
+	rel, err := filepath.Rel(root, osPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: name %q is out of root", ErrInvalidArgument, name)
+	}
+
+	return osPath, nil
+}
+
+func (s *LocalFileSystem) ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error) {
+	dir, err := s.path(parent)
 	if err != nil {
-		return nil, fmt.Errorf("all files info from root dir: %w", err)
+		return nil, err
+	}
+
+	osFilesInfo, err := ioutil.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, errNotDirectory) {
+		return nil, ErrFolderNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("all files info from dir: %w", err)
 	}
 
 	filesInfo := make([]FileInfo, 0, len(osFilesInfo))
 
 	for i := range osFilesInfo {
-		if osFilesInfo[i].IsDir() {
+		// Hidden files are service files like metadata store, they are not available for clients.
+		if strings.HasPrefix(osFilesInfo[i].Name(), ".") {
 			continue
 		}
 
-		filesInfo = append(filesInfo, FileInfo{
-			Name: osFilesInfo[i].Name(),
-			Size: uint64(osFilesInfo[i].Size()),
-		})
+		info := FileInfo{
+			Name:       path.Join(parent, osFilesInfo[i].Name()),
+			ModifiedAt: osFilesInfo[i].ModTime().UTC(),
+			IsFolder:   osFilesInfo[i].IsDir(),
+		}
+		if !info.IsFolder {
+			info.Size = uint64(osFilesInfo[i].Size())
+		}
+
+		filesInfo = append(filesInfo, info)
 	}
 
 	return filesInfo, nil
 }
 
+// SaveFile writes content to temp file near target and replaces target only when all content is written,
+// so readers never see partial content and failed upload does not break existing file.
+// Parent folders of file are created if they do not exist.
 func (s *LocalFileSystem) SaveFile(ctx context.Context, name string, content io.Reader) (size uint64, err error) {
-	name = filepath.Join(s.root, name)
+	name, err = s.path(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return 0, fmt.Errorf("create parent folders: %w", err)
+	}
+
+	tempFilePath, size, err := s.createTempFile(ctx, name, content)
+	if err != nil {
+		return 0, fmt.Errorf("create temp file: %w", err)
+	}
 
-	f, err := os.Create(name)
+	if err = os.Rename(tempFilePath, name); err != nil {
+		os.Remove(tempFilePath)
+		return 0, fmt.Errorf("change file to temp file: %w", err)
+	}
+
+	// Rename is durable only when entry of folder is synced.
+	if err = syncDir(filepath.Dir(name)); err != nil {
+		return 0, fmt.Errorf("sync parent folder: %w", err)
+	}
+
+	return size, nil
+}
+
+func (s *LocalFileSystem) createTempFile(ctx context.Context, name string, content io.Reader) (tempFilePath string, size uint64, err error) {
+	// Temp file is hidden, so it is not listed while it is written.
+	tempFile, err := os.CreateTemp(filepath.Dir(name), fmt.Sprintf(".%s.*.tmp", filepath.Base(name)))
 	if err != nil {
-		return 0, fmt.Errorf("create local file: %w", err)
+		err = fmt.Errorf("create file: %w", err)
+		return
+	}
+	tempFilePath = tempFile.Name()
+	defer func() {
+		if err == nil {
+			return
+		}
+		os.Remove(tempFilePath)
+	}()
+	defer tempFile.Close()
+
+	// Temp file is created only for owner, but saved file must be readable as created by os.Create.
+	if err = tempFile.Chmod(0o644); err != nil {
+		err = fmt.Errorf("change file mode: %w", err)
+		return
 	}
-	defer f.Close()
 
-	written, err := io.Copy(f, content)
+	written, err := io.Copy(tempFile, NewContextReader(ctx, content))
 	if err != nil {
-		return 0, fmt.Errorf("copy passed content to new file: %w", err)
+		err = fmt.Errorf("copy passed content to file: %w", err)
+		return
 	}
 
-	return uint64(written), nil
+	if err = tempFile.Sync(); err != nil {
+		err = fmt.Errorf("sync file: %w", err)
+		return
+	}
+
+	if err = tempFile.Close(); err != nil {
+		err = fmt.Errorf("close file: %w", err)
+		return
+	}
+
+	return tempFilePath, uint64(written), nil
 }
 
-func (s *LocalFileSystem) ReadFile(ctx context.Context, name string) (size uint64, content io.ReadCloser, err error) {
-	name = filepath.Join(s.root, name)
+func (s *LocalFileSystem) StatFile(ctx context.Context, name string) (FileInfo, error) {
+	filePath, err := s.path(name)
+	if err != nil {
+		return FileInfo{}, err
+	}
 
-	f, err := os.Open(name)
-	if errors.Is(err, os.ErrNotExist) {
-		return 0, nil, nil
+	osInfo, err := statFile(filePath)
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	return newLocalFileInfo(name, osInfo), nil
+}
+
+func (s *LocalFileSystem) ReadFile(ctx context.Context, name string) (info FileInfo, content io.ReadSeekCloser, err error) {
+	filePath, err := s.path(name)
+	if err != nil {
+		return FileInfo{}, nil, err
+	}
+
+	f, err := os.Open(filePath)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, errNotDirectory) {
+		return FileInfo{}, nil, nil
 	}
 	if err != nil {
-		return 0, nil, fmt.Errorf("open file: %w", err)
+		return FileInfo{}, nil, fmt.Errorf("open file: %w", err)
 	}
 
-	info, err := f.Stat()
+	osInfo, err := f.Stat()
 	if err != nil {
 		f.Close()
-		return 0, nil, fmt.Errorf("get file info: %w", err)
+		return FileInfo{}, nil, fmt.Errorf("get file info: %w", err)
+	}
+	if osInfo.IsDir() {
+		f.Close()
+		return FileInfo{}, nil, nil
+	}
+
+	return newLocalFileInfo(name, osInfo), f, nil
+}
+
+func (s *LocalFileSystem) DeleteFile(ctx context.Context, name string) error {
+	name, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = statFile(name); err != nil {
+		return err
+	}
+
+	if err = os.Remove(name); err != nil {
+		return fmt.Errorf("remove file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalFileSystem) RenameFile(ctx context.Context, name, newName string) (size uint64, err error) {
+	name, err = s.path(name)
+	if err != nil {
+		return 0, err
+	}
+	newName, err = s.path(newName)
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := statFile(name)
+	if err != nil {
+		return 0, err
 	}
 
-	return uint64(info.Size()), f, nil
+	if err = os.MkdirAll(filepath.Dir(newName), 0o755); err != nil {
+		return 0, fmt.Errorf("create parent folders of new name: %w", err)
+	}
+
+	// Link fails if new name is already taken, unlike os.Rename which silently replaces it.
+	err = os.Link(name, newName)
+	if errors.Is(err, os.ErrExist) {
+		return 0, ErrFileAlreadyExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("link file with new name: %w", err)
+	}
+
+	if err = os.Remove(name); err != nil {
+		os.Remove(newName)
+		return 0, fmt.Errorf("remove file with old name: %w", err)
+	}
+
+	return uint64(info.Size()), nil
+}
+
+// CopyFile writes copy to temp file like SaveFile, so failed or canceled copy does not leave partial file.
+func (s *LocalFileSystem) CopyFile(ctx context.Context, name, newName string) (size uint64, err error) {
+	name, err = s.path(name)
+	if err != nil {
+		return 0, err
+	}
+	newName, err = s.path(newName)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = statFile(name); err != nil {
+		return 0, err
+	}
+
+	src, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, ErrFileNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("open source file: %w", err)
+	}
+	defer src.Close()
+
+	if err = os.MkdirAll(filepath.Dir(newName), 0o755); err != nil {
+		return 0, fmt.Errorf("create parent folders of new name: %w", err)
+	}
+
+	tempFilePath, size, err := s.createTempFile(ctx, newName, src)
+	if err != nil {
+		return 0, fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tempFilePath)
+
+	// Link fails if new name is already taken, unlike os.Rename which silently replaces it.
+	err = os.Link(tempFilePath, newName)
+	if errors.Is(err, os.ErrExist) {
+		return 0, ErrFileAlreadyExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("link temp file with new name: %w", err)
+	}
+
+	if err = syncDir(filepath.Dir(newName)); err != nil {
+		return 0, fmt.Errorf("sync parent folder: %w", err)
+	}
+
+	return size, nil
+}
+
+func (s *LocalFileSystem) CreateFolder(ctx context.Context, name string) error {
+	name, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	return createFolder(name)
+}
+
+func (s *LocalFileSystem) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	name, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if name == s.root {
+		return fmt.Errorf("%w: root folder can not be deleted", ErrInvalidArgument)
+	}
+
+	return deleteFolder(name, recursive)
+}
+
+func createFolder(name string) error {
+	err := os.MkdirAll(name, 0o755)
+	if errors.Is(err, errNotDirectory) || errors.Is(err, os.ErrExist) {
+		return ErrFileAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("create folder: %w", err)
+	}
+
+	return nil
+}
+
+func deleteFolder(name string, recursive bool) error {
+	info, err := os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, errNotDirectory) {
+		return ErrFolderNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("get folder info: %w", err)
+	}
+	if !info.IsDir() {
+		return ErrFolderNotFound
+	}
+
+	if recursive {
+		err = os.RemoveAll(name)
+	} else {
+		err = os.Remove(name)
+	}
+	if errors.Is(err, errDirectoryNotEmpty) {
+		return ErrFolderNotEmpty
+	}
+	if err != nil {
+		return fmt.Errorf("remove folder: %w", err)
+	}
+
+	return nil
+}
+
+// statFile returns info of regular file, folder is not found file.
+func statFile(name string) (os.FileInfo, error) {
+	info, err := os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, errNotDirectory) {
+		return nil, ErrFileNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get file info: %w", err)
+	}
+	if info.IsDir() {
+		return nil, ErrFileNotFound
+	}
+
+	return info, nil
+}
+
+func newLocalFileInfo(name string, osInfo os.FileInfo) FileInfo {
+	return FileInfo{
+		Name:       name,
+		Size:       uint64(osInfo.Size()),
+		ModifiedAt: osInfo.ModTime().UTC(),
+	}
 }
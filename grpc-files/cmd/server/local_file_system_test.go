@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// dirEntries returns names of all files and folders in dir and its sub folders, hidden ones included.
+func dirEntries(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var names []string
+	err := filepath.WalkDir(dir, func(path string, _ os.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		names = append(names, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("walk dir: %v", err)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func readLocalFile(t *testing.T, filesSystem *LocalFileSystem, name string) string {
+	t.Helper()
+
+	_, content, err := filesSystem.ReadFile(context.Background(), name)
+	if err != nil || content == nil {
+		t.Fatalf("read file %s: %v", name, err)
+	}
+	defer content.Close()
+
+	b, err := io.ReadAll(content)
+	if err != nil {
+		t.Fatalf("read content of %s: %v", name, err)
+	}
+	return string(b)
+}
+
+func TestLocalFileSystem_SaveFile(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	readErr := errors.New("read error")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		content io.Reader
+		err     error
+	}{
+		{name: "saved", ctx: context.Background(), content: strings.NewReader("new")},
+		{name: "failed read", ctx: context.Background(), content: io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(readErr)), err: readErr},
+		{name: "canceled", ctx: canceledCtx, content: strings.NewReader("new"), err: context.Canceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			filesSystem := MustNewLocalFileSystem(root)
+			if _, err := filesSystem.SaveFile(context.Background(), "folder/file.txt", strings.NewReader("old")); err != nil {
+				t.Fatalf("save file: %v", err)
+			}
+
+			_, err := filesSystem.SaveFile(tt.ctx, "folder/file.txt", tt.content)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				// Failed save keeps old file and removes temp file.
+				if content := readLocalFile(t, filesSystem, "folder/file.txt"); content != "old" {
+					t.Fatalf("expected old content, got %q", content)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("save file: %v", err)
+				}
+				if content := readLocalFile(t, filesSystem, "folder/file.txt"); content != "new" {
+					t.Fatalf("expected new content, got %q", content)
+				}
+			}
+
+			if entries := strings.Join(dirEntries(t, root), ","); entries != "folder,folder/file.txt" {
+				t.Fatalf("unexpected entries of root %s", entries)
+			}
+		})
+	}
+}
+
+func TestLocalFileSystem_CopyFile(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		newName string
+		err     error
+		entries string
+	}{
+		{name: "copied", ctx: context.Background(), newName: "folder/copy.txt", entries: "file.txt,folder,folder/copy.txt"},
+		{name: "new name is taken", ctx: context.Background(), newName: "taken.txt", err: ErrFileAlreadyExists, entries: "file.txt,taken.txt"},
+		{name: "canceled", ctx: canceledCtx, newName: "folder/copy.txt", err: context.Canceled, entries: "file.txt,folder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			filesSystem := MustNewLocalFileSystem(root)
+			for name, content := range map[string]string{"file.txt": "content", "taken.txt": "taken"} {
+				if _, err := filesSystem.SaveFile(context.Background(), name, strings.NewReader(content)); err != nil {
+					t.Fatalf("save file: %v", err)
+				}
+			}
+			if tt.newName != "taken.txt" {
+				if err := filesSystem.DeleteFile(context.Background(), "taken.txt"); err != nil {
+					t.Fatalf("delete file: %v", err)
+				}
+			}
+
+			size, err := filesSystem.CopyFile(tt.ctx, "file.txt", tt.newName)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+			} else {
+				if err != nil || size != uint64(len("content")) {
+					t.Fatalf("copy file: size %d, error %v", size, err)
+				}
+				if content := readLocalFile(t, filesSystem, tt.newName); content != "content" {
+					t.Fatalf("expected copied content, got %q", content)
+				}
+			}
+
+			// Failed copy leaves neither partial file nor temp file, and taken name is not replaced.
+			if entries := strings.Join(dirEntries(t, root), ","); entries != tt.entries {
+				t.Fatalf("expected entries of root %s, got %s", tt.entries, entries)
+			}
+			if tt.newName == "taken.txt" {
+				if content := readLocalFile(t, filesSystem, "taken.txt"); content != "taken" {
+					t.Fatalf("taken file is replaced by %q", content)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// LoggingUnaryInterceptor takes id of request from metadata of call, which is sent by gateway, or generates it,
+// sends it back in header of response, puts logger of call with this id to context and writes access log of call.
+func LoggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := incomingRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(logging.RequestIDHeader, requestID))
+
+	logger := logging.FromContext(ctx).WithFields(logrus.Fields{"request_id": requestID, "method": info.FullMethod})
+	ctx = logging.ContextWithLogger(logging.ContextWithRequestID(ctx, requestID), logger)
+
+	startedAt := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(logger, info.FullMethod, startedAt, err)
+	return resp, err
+}
+
+// LoggingStreamInterceptor is LoggingUnaryInterceptor of streams, access log has sizes of received and sent messages.
+func LoggingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	requestID := incomingRequestID(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(logging.RequestIDHeader, requestID))
+
+	logger := logging.FromContext(ss.Context()).WithFields(logrus.Fields{"request_id": requestID, "method": info.FullMethod})
+	stream := &loggedServerStream{
+		ServerStream: ss,
+		ctx:          logging.ContextWithLogger(logging.ContextWithRequestID(ss.Context(), requestID), logger),
+	}
+
+	startedAt := time.Now()
+	err := handler(srv, stream)
+	logCall(logger.WithFields(logrus.Fields{
+		"received_bytes": stream.receivedBytes,
+		"sent_bytes":     stream.sentBytes,
+	}), info.FullMethod, startedAt, err)
+	return err
+}
+
+func incomingRequestID(ctx context.Context) string {
+	var fromClient string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logging.RequestIDHeader); len(values) > 0 {
+			fromClient = values[0]
+		}
+	}
+	return logging.RequestID(fromClient)
+}
+
+// logCall logs failed calls with error level if error is fault of server, calls of health service are frequent,
+// they are logged only with debug level.
+func logCall(logger *logrus.Entry, method string, startedAt time.Time, err error) {
+	code := status.Code(err)
+	entry := logger.WithFields(logrus.Fields{
+		"code":             code.String(),
+		"duration_seconds": time.Since(startedAt).Seconds(),
+	})
+	if err != nil {
+		entry = entry.WithError(err)
+	}
+
+	switch {
+	case code == codes.Unknown || code == codes.Internal || code == codes.DataLoss:
+		entry.Error("call")
+	case strings.HasPrefix(method, healthServicePrefix):
+		entry.Debug("call")
+	default:
+		entry.Info("call")
+	}
+}
+
+type loggedServerStream struct {
+	grpc.ServerStream
+	ctx           context.Context
+	receivedBytes int
+	sentBytes     int
+}
+
+func (s *loggedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *loggedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		s.receivedBytes += proto.Size(msg)
+	}
+	return nil
+}
+
+func (s *loggedServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		s.sentBytes += proto.Size(msg)
+	}
+	return nil
+}
@@ -1,32 +1,412 @@
 package main
 
 import (
-	"log"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/config"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/logging"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/signedurl"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/tlsconfig"
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-const tcpAddress = "0.0.0.0:9000"
+const (
+	tracingServiceName       = "files-server"
+	tracingFlushTimeout      = time.Second * 5
+	metricsPath              = "/metrics"
+	metricsReadHeaderTimeout = time.Second * 10
+)
 
 func main() {
-	tcpListener, err := net.Listen("tcp", tcpAddress)
+	cfg := DefaultConfig()
+	printConfig, err := config.Load(&cfg, "SERVER_CONFIG_FILE", os.Args[1:])
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	if printConfig {
+		if err = config.Print(os.Stdout, &cfg); err != nil {
+			logrus.Fatal(err)
+		}
+		return
+	}
+	if err = logging.Setup(cfg.Log); err != nil {
+		logrus.Fatal(err)
+	}
+
+	tlsConfig, err := serverTLSConfig(cfg.TLS)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingServiceName, cfg.Tracing)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	metricsInterceptor, err := NewMetricsInterceptor(registry)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	tcpListener, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
-		log.Fatalln(err)
+		logrus.Fatal(err)
 	}
 	defer tcpListener.Close()
 
-	server := grpc.NewServer()
+	signedURLSigner, signedURLIssuer, err := newSignedURLs(cfg.SignedURL)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	var clientCertAuthenticator *ClientCertAuthenticator
+	if cfg.TLS.ClientCAFile != "" {
+		clientCertAuthenticator = NewClientCertAuthenticator(cfg.Auth.ClientCertProxies)
+	}
+
+	authInterceptor, err := newAuthInterceptor(cfg.Auth, signedURLSigner, clientCertAuthenticator)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{ValidationUnaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{ValidationStreamInterceptor}
+	rateLimitInterceptor := newRateLimitInterceptor(cfg.RateLimit)
+	if rateLimitInterceptor != nil {
+		// Calls are limited by principal after authentication.
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{rateLimitInterceptor.PrincipalUnary}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{rateLimitInterceptor.PrincipalStream}, streamInterceptors...)
+	}
+	if authInterceptor != nil {
+		// Calls are authenticated before anything else is done with them.
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{authInterceptor.Unary}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{authInterceptor.Stream}, streamInterceptors...)
+	} else {
+		logrus.Warn("authentication is disabled, AUTH_API_KEYS, AUTH_JWT_SECRET or TLS_CLIENT_CA_FILE is not set")
+	}
+	if rateLimitInterceptor != nil {
+		// Calls are limited by address before authentication, so flood of calls with bad credentials is rejected cheaply.
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{rateLimitInterceptor.AddressUnary}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{rateLimitInterceptor.AddressStream}, streamInterceptors...)
+	}
+	// Logger of call with id of request is made first, so every log line of call has it, then span of call
+	// is started from trace context of client, so it covers authentication too.
+	unaryInterceptors = append([]grpc.UnaryServerInterceptor{LoggingUnaryInterceptor, otelgrpc.UnaryServerInterceptor(), metricsInterceptor.Unary}, unaryInterceptors...)
+	streamInterceptors = append([]grpc.StreamServerInterceptor{LoggingStreamInterceptor, otelgrpc.StreamServerInterceptor(), metricsInterceptor.Stream}, streamInterceptors...)
+
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		logrus.Warn("tls is disabled, TLS_CERT_FILE is not set")
+	}
+
+	server := grpc.NewServer(serverOptions...)
+
+	var (
+		filesSystem FilesSystem
+		// stateDir is default dir of metadata and upload staging.
+		stateDir string
+	)
+
+	switch cfg.Storage.Backend {
+	case localFilesSystem:
+		localFileSystem := MustNewLocalFileSystem(cfg.Storage.Local.Root)
+		filesSystem, stateDir = localFileSystem, localFileSystem.Root()
+	case contentAddressedFilesSystem:
+		contentAddressedFileSystem := MustNewContentAddressedFileSystem(cfg.Storage.ContentAddressed.Root)
+		filesSystem, stateDir = contentAddressedFileSystem, contentAddressedFileSystem.Root()
+		go collectGarbage(contentAddressedFileSystem, cfg.Storage.ContentAddressed.GarbageCollectPeriod)
+	case s3FilesSystem:
+		filesSystem = MustNewS3FileSystem(context.Background(), cfg.S3Config())
+		if stateDir, err = filepath.Abs(cfg.Storage.S3.StateDir); err != nil {
+			logrus.Fatal(err)
+		}
+	case memoryFilesSystem:
+		memoryFileSystem := NewMemoryFileSystem(MemoryFileSystemConfig{
+			MaxSize:  cfg.Storage.Memory.MaxSize,
+			MaxFiles: cfg.Storage.Memory.MaxFiles,
+		})
+		memoryFileSystem.SetFaults(MemoryFileSystemFaults{
+			FailSaveAfter: cfg.Storage.Memory.FailSaveAfter,
+			ReadDelay:     cfg.Storage.Memory.ReadDelay,
+		})
+		filesSystem = memoryFileSystem
+		// Files are lost on restart, so metadata and uploads are not kept too.
+		if stateDir, err = os.MkdirTemp("", "files-service-"); err != nil {
+			logrus.Fatal(err)
+		}
+	default:
+		logrus.Fatal(fmt.Errorf("unknown files system %q", cfg.Storage.Backend))
+	}
+
+	// Health of storage is checked by backend directly, so checks are not traced and not counted as errors.
+	storageFilesSystem := filesSystem
+	if filesSystem, err = NewInstrumentedFilesSystem(filesSystem, registry); err != nil {
+		logrus.Fatal(err)
+	}
+
+	metadataFilePath := cfg.Storage.MetadataFile
+	if metadataFilePath == "" {
+		metadataFilePath = filepath.Join(stateDir, ".files_metadata.json")
+	}
+	var metadataStore FilesMetadataStore = MustNewJSONFilesMetadataStore(metadataFilePath)
+
+	// Quotas are counted by names of files system, where first folder is folder of principal.
+	quotaFilesSystem, err := NewQuotaFilesSystem(context.Background(), filesSystem, cfg.StorageLimits(), authInterceptor != nil)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	filesSystem = quotaFilesSystem
+
+	// Every principal works with files of its own folder.
+	if authInterceptor != nil {
+		filesSystem = NewNamespacedFilesSystem(filesSystem)
+		metadataStore = NewNamespacedFilesMetadataStore(metadataStore)
+	}
+
+	contentTypesByExtension, err := ParseContentTypesByExtension(cfg.Storage.ContentTypesByExtension)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	contentTypeResolver := NewContentTypeResolver(contentTypesByExtension)
 
-	localFileSystem := MustNewLocalFileSystem(os.Getenv("LOCAL_FILE_SYSTEM_ROOT"))
-	filesService := NewFilesService(localFileSystem)
-	filesServiceServer := NewFilesServiceServer(filesService)
+	uploadStagingDir := cfg.Upload.StagingDir
+	if uploadStagingDir == "" {
+		uploadStagingDir = filepath.Join(stateDir, ".uploads")
+	}
+	uploadStaging := MustNewUploadStaging(uploadStagingDir)
+	go cleanUploadStaging(uploadStaging, cfg.Upload.CleanPeriod, cfg.Upload.SessionTTL)
+
+	filesService := NewFilesService(filesSystem, metadataStore, contentTypeResolver, uploadStaging, quotaFilesSystem, signedURLIssuer)
+	filesServiceServer := NewFilesServiceServer(filesService, cfg.Upload.BufferSize, cfg.Download.ChunkSize, cfg.RateLimit.StreamBytesPerSecond)
 	filesServiceServer.RegistrationGRPC(server)
 
-	log.Println("listen", tcpAddress)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go NewStorageHealthChecker(storageFilesSystem, healthServer, cfg.Health.CheckPeriod, cfg.Health.CheckTimeout).Run(ctx)
+
+	var metricsServer *http.Server
+	if cfg.Metrics.Address != "" {
+		metricsServer = serveMetrics(cfg.Metrics.Address, registry)
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		logrus.WithField("address", cfg.Address).Info("listen")
+		serveErr <- server.Serve(tcpListener)
+	}()
+
+	select {
+	case err = <-serveErr:
+		logrus.Fatal(err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	logrus.WithField("timeout", cfg.ShutdownTimeout.String()).Info("shutdown, drain calls")
+	// Probes see that server is not serving, while running calls are finished.
+	healthServer.Shutdown()
+	if !gracefulStop(server, cfg.ShutdownTimeout) {
+		logrus.Warn("drain timeout is exceeded, running calls are cancelled")
+	}
+
+	if metricsServer != nil {
+		metricsServer.Close()
+	}
+
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), tracingFlushTimeout)
+	defer cancelFlush()
+	if err = shutdownTracing(flushCtx); err != nil {
+		logrus.WithError(err).Error("flush spans")
+	}
+}
+
+// serveMetrics serves metrics of registry on /metrics path of address in background.
+func serveMetrics(address string, gatherer prometheus.Gatherer) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	server := &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: metricsReadHeaderTimeout}
+	go func() {
+		logrus.WithField("address", address).Info("listen metrics")
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Fatal(err)
+		}
+	}()
+
+	return server
+}
+
+// gracefulStop waits for running calls until timeout and then cancels them, it returns false if calls are cancelled.
+func gracefulStop(server *grpc.Server, timeout time.Duration) bool {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		server.Stop()
+		<-stopped
+		return false
+	}
+}
+
+// newSignedURLs returns nil signer and issuer if secret is not set.
+func newSignedURLs(cfg SignedURLConfig) (*signedurl.Signer, *SignedURLIssuer, error) {
+	if cfg.Secret == "" {
+		return nil, nil, nil
+	}
+
+	signer, err := signedurl.NewSigner([]byte(cfg.Secret))
+	if err != nil {
+		return nil, nil, fmt.Errorf("create signer of urls: %w", err)
+	}
+
+	issuer, err := NewSignedURLIssuer(signer, cfg.Base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create issuer of signed urls: %w", err)
+	}
+
+	return signer, issuer, nil
+}
+
+// serverTLSConfig returns nil config if certificate is not set. Certificate, key and CAs of clients
+// are reloaded when their files are changed.
+func serverTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" {
+		return nil, nil
+	}
+
+	// Clients without certificate are authenticated by authorization header, unless certificate is required.
+	config, err := tlsconfig.LoadServerConfig(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile, cfg.RequireClientCert, logrus.StandardLogger())
+	if err != nil {
+		return nil, fmt.Errorf("load tls config: %w", err)
+	}
+
+	return config, nil
+}
+
+// newAuthInterceptor returns nil interceptor if no authenticator is configured.
+// Signed urls are accepted only if authentication is enabled, else they are not needed.
+func newAuthInterceptor(cfg AuthConfig, signedURLSigner *signedurl.Signer, clientCertAuthenticator *ClientCertAuthenticator) (*AuthInterceptor, error) {
+	authenticators := make(map[string]Authenticator)
+
+	apiKeys, err := ParseAPIKeys(cfg.APIKeys)
+	if err != nil {
+		return nil, fmt.Errorf("parse api keys: %w", err)
+	}
+	if len(apiKeys) > 0 {
+		if authenticators[apiKeyAuthScheme], err = NewAPIKeyAuthenticator(apiKeys); err != nil {
+			return nil, fmt.Errorf("create api key authenticator: %w", err)
+		}
+	}
+
+	if cfg.JWT.Secret != "" {
+		authenticators[bearerAuthScheme], err = NewJWTAuthenticator(JWTConfig{
+			Secret:        []byte(cfg.JWT.Secret),
+			Issuer:        cfg.JWT.Issuer,
+			Audience:      cfg.JWT.Audience,
+			AllowNoExpiry: cfg.JWT.AllowNoExpiry,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create jwt authenticator: %w", err)
+		}
+	}
+
+	if len(authenticators) == 0 && clientCertAuthenticator == nil {
+		return nil, nil
+	}
+
+	if signedURLSigner != nil {
+		authenticators[signedURLAuthScheme] = NewSignedURLAuthenticator(signedURLSigner)
+	}
+
+	return NewAuthInterceptor(authenticators, clientCertAuthenticator), nil
+}
+
+// newRateLimitInterceptor returns nil interceptor if calls are limited neither by address nor by principal.
+// Trusted proxies are validated with config.
+func newRateLimitInterceptor(cfg RateLimitConfig) *RateLimitInterceptor {
+	var byAddress, byPrincipal *KeyedRateLimiter
+	if cfg.AddressRate > 0 {
+		byAddress = NewKeyedRateLimiter(cfg.AddressRate, rateLimitBurst(cfg.AddressRate, cfg.AddressBurst))
+	}
+	if cfg.PrincipalRate > 0 {
+		byPrincipal = NewKeyedRateLimiter(cfg.PrincipalRate, rateLimitBurst(cfg.PrincipalRate, cfg.PrincipalBurst))
+	}
+	if byAddress == nil && byPrincipal == nil {
+		return nil
+	}
+
+	trustedProxies, _ := ParseTrustedProxies(cfg.TrustedProxies)
+
+	return NewRateLimitInterceptor(byAddress, byPrincipal, trustedProxies)
+}
+
+// rateLimitBurst is rate rounded up if burst is not set.
+func rateLimitBurst(rate float64, burst int) int {
+	if burst > 0 {
+		return burst
+	}
+	return int(math.Ceil(rate))
+}
+
+func cleanUploadStaging(staging *UploadStaging, period, sessionTTL time.Duration) {
+	for range time.Tick(period) {
+		deleted, err := staging.DeleteExpired(context.Background(), time.Now().Add(-sessionTTL))
+		if err != nil {
+			logrus.WithError(err).Error("clean upload staging")
+		}
+		if deleted > 0 {
+			logrus.WithField("deleted", deleted).Info("deleted expired uploads")
+		}
+	}
+}
 
-	if err = server.Serve(tcpListener); err != nil {
-		log.Fatalln(err)
+func collectGarbage(cas *ContentAddressedFileSystem, period time.Duration) {
+	for range time.Tick(period) {
+		deleted, err := cas.CollectGarbage(context.Background())
+		if err != nil {
+			logrus.WithError(err).Error("collect garbage of content addressed file system")
+		}
+		if deleted > 0 {
+			logrus.WithField("deleted", deleted).Info("deleted not referenced blobs")
+		}
 	}
 }
@@ -0,0 +1,396 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrCapacityExceeded = errors.New("capacity of files system is exceeded")
+	ErrInjectedFault    = errors.New("injected fault")
+)
+
+var _ FilesSystem = (*MemoryFileSystem)(nil)
+
+type MemoryFileSystemConfig struct {
+	// MaxSize is limit of total size of files content, zero is unlimited.
+	MaxSize uint64
+	// MaxFiles is limit of count of files, zero is unlimited.
+	MaxFiles int
+}
+
+// MemoryFileSystemFaults makes MemoryFileSystem fail like broken disk or network storage.
+type MemoryFileSystemFaults struct {
+	// FailSaveAfter fails SaveFile after passed count of bytes of content is read, zero disables fault.
+	FailSaveAfter uint64
+	// ReadDelay is delay before every read of file content.
+	ReadDelay time.Duration
+}
+
+// MemoryFileSystem keeps files in memory, content is lost on restart.
+type MemoryFileSystem struct {
+	config MemoryFileSystemConfig
+
+	mu      sync.RWMutex
+	faults  MemoryFileSystemFaults
+	files   map[string]*memoryFile
+	folders map[string]time.Time
+	size    uint64
+}
+
+// memoryFile content is never changed after save, so it is shared by readers and copies without lock.
+type memoryFile struct {
+	content    []byte
+	modifiedAt time.Time
+}
+
+func (f *memoryFile) info(name string) FileInfo {
+	return FileInfo{
+		Name:       name,
+		Size:       uint64(len(f.content)),
+		ModifiedAt: f.modifiedAt,
+	}
+}
+
+func NewMemoryFileSystem(config MemoryFileSystemConfig) *MemoryFileSystem {
+	return &MemoryFileSystem{
+		config:  config,
+		files:   make(map[string]*memoryFile),
+		folders: make(map[string]time.Time),
+	}
+}
+
+func (s *MemoryFileSystem) SetFaults(faults MemoryFileSystemFaults) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = faults
+}
+
+func (s *MemoryFileSystem) ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.folders[parent]; parent != "" && !ok {
+		return nil, ErrFolderNotFound
+	}
+
+	filesInfo := make([]FileInfo, 0)
+
+	for name, f := range s.files {
+		if parentName(name) == parent {
+			filesInfo = append(filesInfo, f.info(name))
+		}
+	}
+
+	for name, modifiedAt := range s.folders {
+		if parentName(name) == parent {
+			filesInfo = append(filesInfo, FileInfo{
+				Name:       name,
+				ModifiedAt: modifiedAt,
+				IsFolder:   true,
+			})
+		}
+	}
+
+	return filesInfo, nil
+}
+
+func (s *MemoryFileSystem) SaveFile(ctx context.Context, name string, content io.Reader) (uint64, error) {
+	s.mu.RLock()
+	faults := s.faults
+	s.mu.RUnlock()
+
+	content = NewContextReader(ctx, content)
+	if faults.FailSaveAfter > 0 {
+		content = &faultReader{content: content, failAfter: faults.FailSaveAfter}
+	}
+	// Content can not be larger than whole capacity, so it is not read to end if it is.
+	if s.config.MaxSize > 0 {
+		content = io.LimitReader(content, int64(s.config.MaxSize)+1)
+	}
+
+	buf, err := io.ReadAll(content)
+	if err != nil {
+		return 0, fmt.Errorf("read content: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.checkCanCreateFile(name); err != nil {
+		return 0, err
+	}
+
+	var prevSize uint64
+	prev, existed := s.files[name]
+	if existed {
+		prevSize = uint64(len(prev.content))
+	}
+
+	if err = s.checkCapacity(existed, s.size-prevSize+uint64(len(buf))); err != nil {
+		return 0, err
+	}
+
+	s.putFile(name, &memoryFile{content: buf, modifiedAt: time.Now().UTC()})
+
+	return uint64(len(buf)), nil
+}
+
+func (s *MemoryFileSystem) StatFile(ctx context.Context, name string) (FileInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, ok := s.files[name]
+	if !ok {
+		return FileInfo{}, ErrFileNotFound
+	}
+
+	return f.info(name), nil
+}
+
+func (s *MemoryFileSystem) ReadFile(ctx context.Context, name string) (FileInfo, io.ReadSeekCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, ok := s.files[name]
+	if !ok {
+		return FileInfo{}, nil, ErrFileNotFound
+	}
+
+	return f.info(name), &memoryFileReader{
+		ctx:    ctx,
+		Reader: bytes.NewReader(f.content),
+		delay:  s.faults.ReadDelay,
+	}, nil
+}
+
+func (s *MemoryFileSystem) DeleteFile(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.files[name]
+	if !ok {
+		return ErrFileNotFound
+	}
+
+	delete(s.files, name)
+	s.size -= uint64(len(f.content))
+
+	return nil
+}
+
+func (s *MemoryFileSystem) RenameFile(ctx context.Context, name, newName string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.files[name]
+	if !ok {
+		return 0, ErrFileNotFound
+	}
+	if _, ok = s.files[newName]; ok {
+		return 0, ErrFileAlreadyExists
+	}
+	if err := s.checkCanCreateFile(newName); err != nil {
+		return 0, err
+	}
+
+	delete(s.files, name)
+	s.files[newName] = f
+	s.createFolder(parentName(newName), time.Now().UTC())
+
+	return uint64(len(f.content)), nil
+}
+
+func (s *MemoryFileSystem) CopyFile(ctx context.Context, name, newName string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.files[name]
+	if !ok {
+		return 0, ErrFileNotFound
+	}
+	if _, ok = s.files[newName]; ok {
+		return 0, ErrFileAlreadyExists
+	}
+	if err := s.checkCanCreateFile(newName); err != nil {
+		return 0, err
+	}
+
+	size := uint64(len(f.content))
+	if err := s.checkCapacity(false, s.size+size); err != nil {
+		return 0, err
+	}
+
+	s.putFile(newName, &memoryFile{content: f.content, modifiedAt: time.Now().UTC()})
+
+	return size, nil
+}
+
+func (s *MemoryFileSystem) CreateFolder(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.files[name]; ok {
+		return ErrFileAlreadyExists
+	}
+	if err := s.checkCanCreateFile(name); err != nil {
+		return err
+	}
+
+	s.createFolder(name, time.Now().UTC())
+
+	return nil
+}
+
+func (s *MemoryFileSystem) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	if name == "" {
+		return fmt.Errorf("%w: root folder can not be deleted", ErrInvalidArgument)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.folders[name]; !ok {
+		return ErrFolderNotFound
+	}
+
+	prefix := name + "/"
+	var (
+		files   []string
+		folders []string
+	)
+
+	for fileName := range s.files {
+		if strings.HasPrefix(fileName, prefix) {
+			files = append(files, fileName)
+		}
+	}
+	for folderName := range s.folders {
+		if strings.HasPrefix(folderName, prefix) {
+			folders = append(folders, folderName)
+		}
+	}
+
+	if !recursive && len(files)+len(folders) > 0 {
+		return ErrFolderNotEmpty
+	}
+
+	for _, fileName := range files {
+		s.size -= uint64(len(s.files[fileName].content))
+		delete(s.files, fileName)
+	}
+	for _, folderName := range folders {
+		delete(s.folders, folderName)
+	}
+	delete(s.folders, name)
+
+	return nil
+}
+
+// checkCanCreateFile checks that name and its parents are not taken by folder or file.
+func (s *MemoryFileSystem) checkCanCreateFile(name string) error {
+	if _, ok := s.folders[name]; ok {
+		return fmt.Errorf("%w: %s is folder", ErrFileAlreadyExists, name)
+	}
+
+	for parent := parentName(name); parent != ""; parent = parentName(parent) {
+		if _, ok := s.files[parent]; ok {
+			return fmt.Errorf("%w: parent %s is file", ErrFileAlreadyExists, parent)
+		}
+	}
+
+	return nil
+}
+
+func (s *MemoryFileSystem) checkCapacity(replace bool, size uint64) error {
+	if s.config.MaxSize > 0 && size > s.config.MaxSize {
+		return fmt.Errorf("%w: size limit %d bytes", ErrCapacityExceeded, s.config.MaxSize)
+	}
+	if s.config.MaxFiles > 0 && !replace && len(s.files) >= s.config.MaxFiles {
+		return fmt.Errorf("%w: files limit %d", ErrCapacityExceeded, s.config.MaxFiles)
+	}
+
+	return nil
+}
+
+func (s *MemoryFileSystem) putFile(name string, f *memoryFile) {
+	if prev, ok := s.files[name]; ok {
+		s.size -= uint64(len(prev.content))
+	}
+
+	s.files[name] = f
+	s.size += uint64(len(f.content))
+	s.createFolder(parentName(name), f.modifiedAt)
+}
+
+// createFolder creates folder with all parent folders as os.MkdirAll.
+func (s *MemoryFileSystem) createFolder(name string, modifiedAt time.Time) {
+	for ; name != ""; name = parentName(name) {
+		if _, ok := s.folders[name]; ok {
+			return
+		}
+		s.folders[name] = modifiedAt
+	}
+}
+
+// parentName returns name of parent folder, empty for root folder.
+func parentName(name string) string {
+	parent := path.Dir(name)
+	if parent == "." {
+		return ""
+	}
+	return parent
+}
+
+type memoryFileReader struct {
+	ctx context.Context
+	*bytes.Reader
+	delay time.Duration
+}
+
+func (r *memoryFileReader) Read(dst []byte) (int, error) {
+	if r.delay > 0 {
+		timer := time.NewTimer(r.delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-r.ctx.Done():
+			return 0, r.ctx.Err()
+		}
+	}
+
+	return r.Reader.Read(dst)
+}
+
+func (r *memoryFileReader) Close() error {
+	return nil
+}
+
+type faultReader struct {
+	content   io.Reader
+	failAfter uint64
+	read      uint64
+}
+
+func (r *faultReader) Read(dst []byte) (int, error) {
+	if r.read >= r.failAfter {
+		return 0, ErrInjectedFault
+	}
+	if uint64(len(dst)) > r.failAfter-r.read {
+		dst = dst[:r.failAfter-r.read]
+	}
+
+	n, err := r.content.Read(dst)
+	r.read += uint64(n)
+
+	return n, err
+}
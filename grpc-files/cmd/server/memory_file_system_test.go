@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryFileSystem_Capacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   MemoryFileSystemConfig
+		saveName string
+		content  string
+		exceeded bool
+	}{
+		{name: "in size limit", config: MemoryFileSystemConfig{MaxSize: 10}, saveName: "b", content: "12345"},
+		{name: "over size limit", config: MemoryFileSystemConfig{MaxSize: 10}, saveName: "b", content: "123456", exceeded: true},
+		{name: "larger than whole capacity", config: MemoryFileSystemConfig{MaxSize: 10}, saveName: "b", content: strings.Repeat("1", 100), exceeded: true},
+		{name: "replace in size limit", config: MemoryFileSystemConfig{MaxSize: 10}, saveName: "a", content: "1234567890"},
+		{name: "over files limit", config: MemoryFileSystemConfig{MaxFiles: 1}, saveName: "b", content: "1", exceeded: true},
+		{name: "replace in files limit", config: MemoryFileSystemConfig{MaxFiles: 1}, saveName: "a", content: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			filesSystem := NewMemoryFileSystem(tt.config)
+			if _, err := filesSystem.SaveFile(ctx, "a", strings.NewReader("12345")); err != nil {
+				t.Fatalf("save file: %v", err)
+			}
+
+			_, err := filesSystem.SaveFile(ctx, tt.saveName, strings.NewReader(tt.content))
+			if tt.exceeded {
+				if !errors.Is(err, ErrCapacityExceeded) {
+					t.Fatalf("expected ErrCapacityExceeded, got %v", err)
+				}
+				if _, err = filesSystem.StatFile(ctx, tt.saveName); !errors.Is(err, ErrFileNotFound) {
+					t.Fatalf("expected not saved file, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("save file: %v", err)
+			}
+		})
+	}
+}
+
+func TestMemoryFileSystem_CopyFileCapacity(t *testing.T) {
+	ctx := context.Background()
+	filesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{MaxSize: 8})
+	if _, err := filesSystem.SaveFile(ctx, "a", strings.NewReader("12345")); err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+
+	if _, err := filesSystem.CopyFile(ctx, "a", "b"); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded, got %v", err)
+	}
+
+	// Deleted file frees capacity.
+	if err := filesSystem.DeleteFile(ctx, "a"); err != nil {
+		t.Fatalf("delete file: %v", err)
+	}
+	if _, err := filesSystem.SaveFile(ctx, "c", strings.NewReader("12345678")); err != nil {
+		t.Fatalf("save file after delete: %v", err)
+	}
+}
+
+func TestMemoryFileSystem_FailSaveAfter(t *testing.T) {
+	ctx := context.Background()
+	filesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	if _, err := filesSystem.SaveFile(ctx, "a", strings.NewReader("old")); err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+
+	filesSystem.SetFaults(MemoryFileSystemFaults{FailSaveAfter: 4})
+
+	if _, err := filesSystem.SaveFile(ctx, "a", strings.NewReader("new content")); !errors.Is(err, ErrInjectedFault) {
+		t.Fatalf("expected ErrInjectedFault, got %v", err)
+	}
+
+	// Failed save does not replace file.
+	_, content, err := filesSystem.ReadFile(ctx, "a")
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	defer content.Close()
+	if b, _ := io.ReadAll(content); string(b) != "old" {
+		t.Fatalf("expected old content, got %q", b)
+	}
+
+	// Content shorter than fault is saved.
+	if _, err = filesSystem.SaveFile(ctx, "b", strings.NewReader("new")); err != nil {
+		t.Fatalf("save file shorter than fault: %v", err)
+	}
+
+	filesSystem.SetFaults(MemoryFileSystemFaults{})
+	if _, err = filesSystem.SaveFile(ctx, "a", strings.NewReader("new content")); err != nil {
+		t.Fatalf("save file after fault is disabled: %v", err)
+	}
+}
+
+func TestMemoryFileSystem_ReadDelay(t *testing.T) {
+	filesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	if _, err := filesSystem.SaveFile(context.Background(), "a", strings.NewReader("content")); err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+	filesSystem.SetFaults(MemoryFileSystemFaults{ReadDelay: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, content, err := filesSystem.ReadFile(ctx, "a")
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	defer content.Close()
+
+	// Delayed read is interrupted by context.
+	if _, err = io.ReadAll(content); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestMemoryFileSystem_Folders(t *testing.T) {
+	ctx := context.Background()
+	filesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	if _, err := filesSystem.SaveFile(ctx, "a/b/c.txt", strings.NewReader("content")); err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+
+	infos, err := filesSystem.ListFilesInfo(ctx, "a")
+	if err != nil {
+		t.Fatalf("list files: %v", err)
+	}
+	if len(infos) != 1 || infos[0].Name != "a/b" || !infos[0].IsFolder {
+		t.Fatalf("expected parent folders of saved file, got %v", infos)
+	}
+
+	if _, err = filesSystem.ListFilesInfo(ctx, "missing"); !errors.Is(err, ErrFolderNotFound) {
+		t.Fatalf("expected ErrFolderNotFound, got %v", err)
+	}
+	if _, err = filesSystem.SaveFile(ctx, "a/b/c.txt/d.txt", strings.NewReader("content")); !errors.Is(err, ErrFileAlreadyExists) {
+		t.Fatalf("expected ErrFileAlreadyExists of file under file, got %v", err)
+	}
+	if _, _, err = filesSystem.ReadFile(ctx, "a/b"); !errors.Is(err, ErrFileNotFound) {
+		t.Fatalf("expected ErrFileNotFound of folder, got %v", err)
+	}
+
+	if err = filesSystem.DeleteFolder(ctx, "a", false); !errors.Is(err, ErrFolderNotEmpty) {
+		t.Fatalf("expected ErrFolderNotEmpty, got %v", err)
+	}
+	if err = filesSystem.DeleteFolder(ctx, "a", true); err != nil {
+		t.Fatalf("delete folder: %v", err)
+	}
+
+	infos, err = filesSystem.ListFilesInfo(ctx, "")
+	if err != nil {
+		t.Fatalf("list files: %v", err)
+	}
+	if len(infos) != 0 {
+		t.Fatalf("expected empty root folder, got %v", infos)
+	}
+}
+
+func TestMemoryFileSystem_RenameFile(t *testing.T) {
+	ctx := context.Background()
+	filesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	for _, name := range []string{"a", "b"} {
+		if _, err := filesSystem.SaveFile(ctx, name, strings.NewReader(name)); err != nil {
+			t.Fatalf("save file: %v", err)
+		}
+	}
+
+	if _, err := filesSystem.RenameFile(ctx, "a", "b"); !errors.Is(err, ErrFileAlreadyExists) {
+		t.Fatalf("expected ErrFileAlreadyExists, got %v", err)
+	}
+	if _, err := filesSystem.RenameFile(ctx, "missing", "c"); !errors.Is(err, ErrFileNotFound) {
+		t.Fatalf("expected ErrFileNotFound, got %v", err)
+	}
+	if _, err := filesSystem.RenameFile(ctx, "a", "folder/c"); err != nil {
+		t.Fatalf("rename file: %v", err)
+	}
+
+	var names []string
+	for _, parent := range []string{"", "folder"} {
+		infos, err := filesSystem.ListFilesInfo(ctx, parent)
+		if err != nil {
+			t.Fatalf("list files: %v", err)
+		}
+		for _, info := range infos {
+			names = append(names, info.Name)
+		}
+	}
+	sort.Strings(names)
+	if got := strings.Join(names, ","); got != "b,folder,folder/c" {
+		t.Fatalf("unexpected files %s", got)
+	}
+}
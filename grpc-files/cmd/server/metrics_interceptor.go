@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Directions of streamed bytes.
+const (
+	receivedDirection = "received"
+	sentDirection     = "sent"
+)
+
+// MetricsInterceptor counts calls by methods and codes, measures their latencies, bytes of messages
+// of streams and count of active streams.
+type MetricsInterceptor struct {
+	requests      *prometheus.CounterVec
+	latency       *prometheus.HistogramVec
+	streamedBytes *prometheus.CounterVec
+	activeStreams *prometheus.GaugeVec
+}
+
+func NewMetricsInterceptor(registerer prometheus.Registerer) (*MetricsInterceptor, error) {
+	i := MetricsInterceptor{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_requests_total",
+			Help: "Count of finished calls by methods and codes.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_request_duration_seconds",
+			Help:    "Latency of calls by methods, streams are measured until they are finished.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"method"}),
+		streamedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_streamed_bytes_total",
+			Help: "Size of messages of streams by methods and directions, received or sent.",
+		}, []string{"method", "direction"}),
+		activeStreams: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "grpc_server_active_streams",
+			Help: "Count of running streams by methods.",
+		}, []string{"method"}),
+	}
+
+	for _, collector := range []prometheus.Collector{i.requests, i.latency, i.streamedBytes, i.activeStreams} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return &i, nil
+}
+
+func (i *MetricsInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	startedAt := time.Now()
+	resp, err := handler(ctx, req)
+	i.observe(info.FullMethod, startedAt, err)
+	return resp, err
+}
+
+func (i *MetricsInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	activeStreams := i.activeStreams.WithLabelValues(info.FullMethod)
+	activeStreams.Inc()
+	defer activeStreams.Dec()
+
+	startedAt := time.Now()
+	err := handler(srv, &measuredServerStream{
+		ServerStream:  ss,
+		receivedBytes: i.streamedBytes.WithLabelValues(info.FullMethod, receivedDirection),
+		sentBytes:     i.streamedBytes.WithLabelValues(info.FullMethod, sentDirection),
+	})
+	i.observe(info.FullMethod, startedAt, err)
+	return err
+}
+
+func (i *MetricsInterceptor) observe(method string, startedAt time.Time, err error) {
+	i.latency.WithLabelValues(method).Observe(time.Since(startedAt).Seconds())
+	i.requests.WithLabelValues(method, status.Code(err).String()).Inc()
+}
+
+type measuredServerStream struct {
+	grpc.ServerStream
+	receivedBytes prometheus.Counter
+	sentBytes     prometheus.Counter
+}
+
+func (s *measuredServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		s.receivedBytes.Add(float64(proto.Size(msg)))
+	}
+	return nil
+}
+
+func (s *measuredServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		s.sentBytes.Add(float64(proto.Size(msg)))
+	}
+	return nil
+}
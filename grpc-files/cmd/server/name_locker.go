@@ -0,0 +1,80 @@
+package main
+
+import (
+	"sort"
+	"sync"
+)
+
+// NameLocker gives exclusive access to files by names, so changes of one file are serialized,
+// while changes of other files go on. Lock of name is dropped when nobody holds or waits for it.
+type NameLocker struct {
+	mu    sync.Mutex
+	locks map[string]*nameLock
+}
+
+type nameLock struct {
+	mu sync.Mutex
+	// refs is count of holders and waiters of lock.
+	refs int
+}
+
+func NewNameLocker() *NameLocker {
+	return &NameLocker{locks: make(map[string]*nameLock)}
+}
+
+// Lock locks all names, names are locked in order, so two callers locking same names do not deadlock.
+func (l *NameLocker) Lock(names ...string) (unlock func()) {
+	names = uniqueSortedNames(names)
+
+	locks := make([]*nameLock, len(names))
+	for i, name := range names {
+		locks[i] = l.acquire(name)
+		locks[i].mu.Lock()
+	}
+
+	return func() {
+		for i := len(names) - 1; i >= 0; i-- {
+			locks[i].mu.Unlock()
+			l.release(names[i])
+		}
+	}
+}
+
+func (l *NameLocker) acquire(name string) *nameLock {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock, ok := l.locks[name]
+	if !ok {
+		lock = &nameLock{}
+		l.locks[name] = lock
+	}
+	lock.refs++
+
+	return lock
+}
+
+func (l *NameLocker) release(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock := l.locks[name]
+	lock.refs--
+	if lock.refs == 0 {
+		delete(l.locks, name)
+	}
+}
+
+func uniqueSortedNames(names []string) []string {
+	sorted := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
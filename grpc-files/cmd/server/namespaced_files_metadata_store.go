@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+)
+
+var _ FilesMetadataStore = (*NamespacedFilesMetadataStore)(nil)
+
+// NamespacedFilesMetadataStore keeps metadata by names of NamespacedFilesSystem.
+type NamespacedFilesMetadataStore struct {
+	metadataStore FilesMetadataStore
+}
+
+func NewNamespacedFilesMetadataStore(metadataStore FilesMetadataStore) *NamespacedFilesMetadataStore {
+	return &NamespacedFilesMetadataStore{metadataStore: metadataStore}
+}
+
+func (s *NamespacedFilesMetadataStore) GetFileMetadata(ctx context.Context, name string) (*FileMetadata, error) {
+	return s.metadataStore.GetFileMetadata(ctx, namespacedName(ctx, name))
+}
+
+func (s *NamespacedFilesMetadataStore) SaveFileMetadata(ctx context.Context, name string, metadata FileMetadata) error {
+	return s.metadataStore.SaveFileMetadata(ctx, namespacedName(ctx, name), metadata)
+}
+
+func (s *NamespacedFilesMetadataStore) DeleteFileMetadata(ctx context.Context, name string) error {
+	return s.metadataStore.DeleteFileMetadata(ctx, namespacedName(ctx, name))
+}
+
+func (s *NamespacedFilesMetadataStore) DeleteFolderMetadata(ctx context.Context, name string) error {
+	return s.metadataStore.DeleteFolderMetadata(ctx, namespacedName(ctx, name))
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var _ FilesSystem = (*NamespacedFilesSystem)(nil)
+
+// NamespacedFilesSystem confines principal of context to its own folder, names of files are relative
+// to folder of principal. Names are passed as is if there is no principal in context.
+type NamespacedFilesSystem struct {
+	filesSystem FilesSystem
+}
+
+func NewNamespacedFilesSystem(filesSystem FilesSystem) *NamespacedFilesSystem {
+	return &NamespacedFilesSystem{filesSystem: filesSystem}
+}
+
+func (s *NamespacedFilesSystem) ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error) {
+	filesInfo, err := s.filesSystem.ListFilesInfo(ctx, namespacedName(ctx, parent))
+	// Folder of principal is created by first saved file.
+	if errors.Is(err, ErrFolderNotFound) && parent == "" {
+		return make([]FileInfo, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range filesInfo {
+		filesInfo[i].Name = principalName(ctx, filesInfo[i].Name)
+	}
+
+	return filesInfo, nil
+}
+
+func (s *NamespacedFilesSystem) SaveFile(ctx context.Context, name string, content io.Reader) (uint64, error) {
+	return s.filesSystem.SaveFile(ctx, namespacedName(ctx, name), content)
+}
+
+func (s *NamespacedFilesSystem) StatFile(ctx context.Context, name string) (FileInfo, error) {
+	info, err := s.filesSystem.StatFile(ctx, namespacedName(ctx, name))
+	info.Name = name
+	return info, err
+}
+
+func (s *NamespacedFilesSystem) ReadFile(ctx context.Context, name string) (FileInfo, io.ReadSeekCloser, error) {
+	info, content, err := s.filesSystem.ReadFile(ctx, namespacedName(ctx, name))
+	info.Name = name
+	return info, content, err
+}
+
+func (s *NamespacedFilesSystem) DeleteFile(ctx context.Context, name string) error {
+	return s.filesSystem.DeleteFile(ctx, namespacedName(ctx, name))
+}
+
+func (s *NamespacedFilesSystem) RenameFile(ctx context.Context, name, newName string) (uint64, error) {
+	return s.filesSystem.RenameFile(ctx, namespacedName(ctx, name), namespacedName(ctx, newName))
+}
+
+func (s *NamespacedFilesSystem) CopyFile(ctx context.Context, name, newName string) (uint64, error) {
+	return s.filesSystem.CopyFile(ctx, namespacedName(ctx, name), namespacedName(ctx, newName))
+}
+
+func (s *NamespacedFilesSystem) CreateFolder(ctx context.Context, name string) error {
+	return s.filesSystem.CreateFolder(ctx, namespacedName(ctx, name))
+}
+
+func (s *NamespacedFilesSystem) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	// Root folder of principal is its folder in files system, it can not be deleted as root folder.
+	if name == "" {
+		return fmt.Errorf("%w: root folder can not be deleted", ErrInvalidArgument)
+	}
+	return s.filesSystem.DeleteFolder(ctx, namespacedName(ctx, name), recursive)
+}
+
+// namespacedName returns name in files system of name in folder of principal, empty name is root folder.
+// Name "~<principal>/<name>" points to file in folder of other principal.
+func namespacedName(ctx context.Context, name string) string {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return name
+	}
+
+	owner, name := splitNamespace(principal, name)
+	if name == "" {
+		return owner
+	}
+	return owner + "/" + name
+}
+
+// principalName is reverse of namespacedName.
+func principalName(ctx context.Context, name string) string {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return name
+	}
+
+	if name == principal.ID || strings.HasPrefix(name, principal.ID+"/") {
+		return strings.TrimPrefix(strings.TrimPrefix(name, principal.ID), "/")
+	}
+	return "~" + name
+}
+
+// namespaceOwner returns id of principal whose folder contains file, empty if authentication is disabled.
+func namespaceOwner(ctx context.Context, name string) string {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return ""
+	}
+
+	owner, _ := splitNamespace(principal, name)
+	return owner
+}
+
+// splitNamespace splits name to owner of folder and name in folder. Name with tilde which is not followed
+// by valid principal id is name of file in own folder.
+func splitNamespace(principal *Principal, name string) (owner, nameInFolder string) {
+	first, rest, _ := strings.Cut(name, "/")
+	if !strings.HasPrefix(first, "~") {
+		return principal.ID, name
+	}
+
+	owner = strings.TrimPrefix(first, "~")
+	if ValidatePrincipalID(owner) != nil {
+		return principal.ID, name
+	}
+
+	return owner, rest
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNamespacedName(t *testing.T) {
+	alice := &Principal{ID: "alice"}
+
+	tests := []struct {
+		name          string
+		principal     *Principal
+		fileName      string
+		namespaced    string
+		principalName string
+	}{
+		{name: "without principal", fileName: "a/b.txt", namespaced: "a/b.txt", principalName: "a/b.txt"},
+		{name: "own file", principal: alice, fileName: "a/b.txt", namespaced: "alice/a/b.txt", principalName: "a/b.txt"},
+		{name: "own root", principal: alice, fileName: "", namespaced: "alice", principalName: ""},
+		{name: "own file by tilde", principal: alice, fileName: "~alice/a.txt", namespaced: "alice/a.txt", principalName: "a.txt"},
+		{name: "file of other principal", principal: alice, fileName: "~bob/a/b.txt", namespaced: "bob/a/b.txt", principalName: "~bob/a/b.txt"},
+		{name: "root of other principal", principal: alice, fileName: "~bob", namespaced: "bob", principalName: "~bob"},
+		{name: "tilde without principal id", principal: alice, fileName: "~/a.txt", namespaced: "alice/~/a.txt", principalName: "~/a.txt"},
+		{name: "tilde before invalid principal id", principal: alice, fileName: "~../a.txt", namespaced: "alice/~../a.txt", principalName: "~../a.txt"},
+		{name: "tilde inside of name", principal: alice, fileName: "a/~bob.txt", namespaced: "alice/a/~bob.txt", principalName: "a/~bob.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = ContextWithPrincipal(ctx, tt.principal)
+			}
+
+			namespaced := namespacedName(ctx, tt.fileName)
+			if namespaced != tt.namespaced {
+				t.Fatalf("expected namespaced name %q, got %q", tt.namespaced, namespaced)
+			}
+			if got := principalName(ctx, namespaced); got != tt.principalName {
+				t.Fatalf("expected principal name %q, got %q", tt.principalName, got)
+			}
+		})
+	}
+}
+
+func TestNamespacedFilesSystem(t *testing.T) {
+	memoryFilesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	filesSystem := NewNamespacedFilesSystem(memoryFilesSystem)
+
+	aliceCtx := ContextWithPrincipal(context.Background(), &Principal{ID: "alice"})
+	bobCtx := ContextWithPrincipal(context.Background(), &Principal{ID: "bob"})
+	carolCtx := ContextWithPrincipal(context.Background(), &Principal{ID: "carol"})
+
+	for ctx, content := range map[context.Context]string{aliceCtx: "alice", bobCtx: "bob"} {
+		if _, err := filesSystem.SaveFile(ctx, "a.txt", strings.NewReader(content)); err != nil {
+			t.Fatalf("save file: %v", err)
+		}
+	}
+
+	listNames := func(ctx context.Context, parent string) string {
+		t.Helper()
+
+		infos, err := filesSystem.ListFilesInfo(ctx, parent)
+		if err != nil {
+			t.Fatalf("list files of %q: %v", parent, err)
+		}
+		names := make([]string, 0, len(infos))
+		for _, info := range infos {
+			names = append(names, info.Name)
+		}
+		sort.Strings(names)
+		return strings.Join(names, ",")
+	}
+
+	if names := listNames(aliceCtx, ""); names != "a.txt" {
+		t.Fatalf("unexpected own files %s", names)
+	}
+	if names := listNames(aliceCtx, "~bob"); names != "~bob/a.txt" {
+		t.Fatalf("unexpected files of other principal %s", names)
+	}
+	// Folder of principal without files does not exist yet.
+	if names := listNames(carolCtx, ""); names != "" {
+		t.Fatalf("unexpected files of new principal %s", names)
+	}
+	if _, err := filesSystem.ListFilesInfo(carolCtx, "folder"); !errors.Is(err, ErrFolderNotFound) {
+		t.Fatalf("expected ErrFolderNotFound, got %v", err)
+	}
+
+	info, err := filesSystem.StatFile(aliceCtx, "~bob/a.txt")
+	if err != nil || info.Name != "~bob/a.txt" || info.Size != uint64(len("bob")) {
+		t.Fatalf("unexpected info %+v of file of other principal, error %v", info, err)
+	}
+
+	// Names of both sides of rename and copy are in namespaces, authorization is done by files service.
+	if _, err = filesSystem.RenameFile(aliceCtx, "a.txt", "~bob/from-alice.txt"); err != nil {
+		t.Fatalf("rename file to folder of other principal: %v", err)
+	}
+	if _, err = filesSystem.CopyFile(aliceCtx, "~bob/a.txt", "from-bob.txt"); err != nil {
+		t.Fatalf("copy file from folder of other principal: %v", err)
+	}
+
+	var stored []string
+	for _, folder := range []string{"alice", "bob"} {
+		infos, err := memoryFilesSystem.ListFilesInfo(context.Background(), folder)
+		if err != nil {
+			t.Fatalf("list files of %s: %v", folder, err)
+		}
+		for _, info := range infos {
+			stored = append(stored, info.Name)
+		}
+	}
+	sort.Strings(stored)
+	if got := strings.Join(stored, ","); got != "alice/from-bob.txt,bob/a.txt,bob/from-alice.txt" {
+		t.Fatalf("unexpected stored files %s", got)
+	}
+
+	if err = filesSystem.DeleteFolder(aliceCtx, "", true); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument of own root folder, got %v", err)
+	}
+}
+
+func TestNamespacedFilesSystem_Confinement(t *testing.T) {
+	server := newAPIKeyTestServer(t, Principal{ID: "alice"}, Principal{ID: "bob"}, Principal{ID: "root", Roles: []string{adminRole}})
+	aliceCtx, bobCtx, rootCtx := apiKeyContext("alice"), apiKeyContext("bob"), apiKeyContext("root")
+
+	for ctx, content := range map[context.Context]string{aliceCtx: "alice", bobCtx: "bob"} {
+		if _, err := server.upload(ctx, &files.UploadFileRequest_Info{Name: "a.txt"}, content, 7); err != nil {
+			t.Fatalf("upload file: %v", err)
+		}
+	}
+
+	// Principal sees own file by same name.
+	header, content, _, err := server.download(aliceCtx, "a.txt", 0, 0)
+	if err != nil || header.GetName() != "a.txt" || content != "alice" {
+		t.Fatalf("unexpected own file %v %q, error %v", header, content, err)
+	}
+	if _, err = server.client.GetFileHeader(aliceCtx, &files.GetFileHeaderRequest{Name: "~alice/a.txt"}); err != nil {
+		t.Fatalf("get own file by tilde: %v", err)
+	}
+
+	denied := []struct {
+		name string
+		call func() error
+	}{
+		{name: "get header of file of other principal", call: func() error {
+			_, err := server.client.GetFileHeader(aliceCtx, &files.GetFileHeaderRequest{Name: "~bob/a.txt"})
+			return err
+		}},
+		{name: "download file of other principal", call: func() error {
+			_, _, _, err := server.download(aliceCtx, "~bob/a.txt", 0, 0)
+			return err
+		}},
+		{name: "upload to folder of other principal", call: func() error {
+			_, err := server.upload(aliceCtx, &files.UploadFileRequest_Info{Name: "~bob/b.txt"}, "alice", 7)
+			return err
+		}},
+		{name: "rename to folder of other principal", call: func() error {
+			_, err := server.client.RenameFile(aliceCtx, &files.RenameFileRequest{Name: "a.txt", NewName: "~bob/b.txt"})
+			return err
+		}},
+		{name: "rename from folder of other principal", call: func() error {
+			_, err := server.client.RenameFile(aliceCtx, &files.RenameFileRequest{Name: "~bob/a.txt", NewName: "b.txt"})
+			return err
+		}},
+		{name: "copy from folder of other principal", call: func() error {
+			_, err := server.client.CopyFile(aliceCtx, &files.CopyFileRequest{Name: "~bob/a.txt", NewName: "b.txt"})
+			return err
+		}},
+		{name: "copy to folder of other principal", call: func() error {
+			_, err := server.client.CopyFile(aliceCtx, &files.CopyFileRequest{Name: "a.txt", NewName: "~bob/b.txt"})
+			return err
+		}},
+	}
+	for _, tt := range denied {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != codes.PermissionDenied {
+				t.Fatalf("expected PermissionDenied, got %v", err)
+			}
+		})
+	}
+
+	// Only files shared with principal are listed in folder of other principal.
+	list, err := server.client.ListFilesHeader(aliceCtx, &files.ListFilesHeaderRequest{Parent: "~bob"})
+	if err != nil || len(list.GetItems()) != 0 {
+		t.Fatalf("expected no listed files of other principal, got %v, error %v", list.GetItems(), err)
+	}
+
+	// Admin moves files between folders of principals.
+	copied, err := server.client.CopyFile(rootCtx, &files.CopyFileRequest{Name: "~bob/a.txt", NewName: "~alice/from-bob.txt"})
+	if err != nil {
+		t.Fatalf("copy file by admin: %v", err)
+	}
+	if name := copied.GetFileHeader().GetName(); name != "~alice/from-bob.txt" {
+		t.Fatalf("unexpected name of copy %s", name)
+	}
+	if _, err = server.client.RenameFile(rootCtx, &files.RenameFileRequest{Name: "~alice/a.txt", NewName: "~bob/from-alice.txt"}); err != nil {
+		t.Fatalf("rename file by admin: %v", err)
+	}
+
+	if _, content, _, err = server.download(aliceCtx, "from-bob.txt", 0, 0); err != nil || content != "bob" {
+		t.Fatalf("unexpected copied file %q, error %v", content, err)
+	}
+	if _, content, _, err = server.download(bobCtx, "from-alice.txt", 0, 0); err != nil || content != "alice" {
+		t.Fatalf("unexpected renamed file %q, error %v", content, err)
+	}
+
+	var stored []string
+	for _, folder := range []string{"alice", "bob"} {
+		infos, err := server.filesSystem.ListFilesInfo(context.Background(), folder)
+		if err != nil {
+			t.Fatalf("list files of %s: %v", folder, err)
+		}
+		for _, info := range infos {
+			stored = append(stored, info.Name)
+		}
+	}
+	sort.Strings(stored)
+	if got := strings.Join(stored, ","); got != "alice/from-bob.txt,bob/a.txt,bob/from-alice.txt" {
+		t.Fatalf("unexpected stored files %s", got)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// adminRole gives full access to files of all principals.
+const adminRole = "admin"
+
+// Principal is authenticated client, its files are kept in own folder named by id.
+type Principal struct {
+	ID    string
+	Roles []string
+	// Scope limits access of principal, it is nil for full access.
+	Scope *PrincipalScope
+}
+
+// PrincipalScope allows only listed gRPC methods with one file, for example principal of signed URL.
+type PrincipalScope struct {
+	// FullMethods are like "/example.files.v1.FilesService/DownloadFile".
+	FullMethods []string
+	Name        string
+}
+
+func (s *PrincipalScope) AllowsMethod(fullMethod string) bool {
+	for _, m := range s.FullMethods {
+		if m == fullMethod {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalContextKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns nil if authentication is disabled.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalContextKey{}).(*Principal)
+	return principal
+}
+
+// ValidatePrincipalID checks that id can be name of folder of principal.
+func ValidatePrincipalID(id string) error {
+	if err := ValidateName(id); err != nil {
+		return fmt.Errorf("principal id: %w", err)
+	}
+	if strings.Contains(id, "/") {
+		return fmt.Errorf("%w: principal id %q contains slash", ErrInvalidArgument, id)
+	}
+	// Tilde prefix marks folder of other principal in names of files.
+	if strings.HasPrefix(id, "~") {
+		return fmt.Errorf("%w: principal id %q starts with tilde", ErrInvalidArgument, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,470 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync"
+)
+
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+var _ FilesSystem = (*QuotaFilesSystem)(nil)
+
+// StorageLimits are limits of stored files, zero limit is unlimited.
+type StorageLimits struct {
+	MaxFileSize uint64
+	MaxBytes    uint64
+	MaxFiles    uint64
+	// MaxBytesPerPrincipal and MaxFilesPerPrincipal limit folder of every principal,
+	// they are applied only if files system is namespaced.
+	MaxBytesPerPrincipal uint64
+	MaxFilesPerPrincipal uint64
+}
+
+// StorageUsage is used bytes and count of files with their limits.
+type StorageUsage struct {
+	Bytes    uint64
+	Files    uint64
+	MaxBytes uint64
+	MaxFiles uint64
+}
+
+type UsageReport struct {
+	// Principal is nil if files system is not namespaced.
+	Principal   *StorageUsage
+	Total       StorageUsage
+	MaxFileSize uint64
+}
+
+// QuotaFilesSystem counts size and count of files of every principal and of whole files system and rejects
+// changes which exceed limits. Saved content is counted while it is read, so save is aborted as soon as limit
+// is crossed, and bytes being saved are reserved, so concurrent saves can not exceed limit together.
+// Usage is counted by files system on start, changes made not over service are not seen.
+type QuotaFilesSystem struct {
+	filesSystem FilesSystem
+	limits      StorageLimits
+	// namespaced means that first folder of name is folder of principal, see NamespacedFilesSystem.
+	namespaced bool
+
+	mu      sync.Mutex
+	total   usageCounter
+	byOwner map[string]*usageCounter
+}
+
+type usageCounter struct {
+	bytes int64
+	files int64
+}
+
+func NewQuotaFilesSystem(ctx context.Context, filesSystem FilesSystem, limits StorageLimits, namespaced bool) (*QuotaFilesSystem, error) {
+	s := &QuotaFilesSystem{
+		filesSystem: filesSystem,
+		limits:      limits,
+		namespaced:  namespaced,
+		byOwner:     make(map[string]*usageCounter),
+	}
+
+	err := walkFiles(ctx, filesSystem, "", func(info FileInfo) {
+		_ = s.add(s.owner(info.Name), int64(info.Size), 1, false)
+	})
+	if err != nil && !errors.Is(err, ErrFolderNotFound) {
+		return nil, fmt.Errorf("count usage of files system: %w", err)
+	}
+
+	return s, nil
+}
+
+// Usage returns usage of folder of owner and of whole files system, owner is ignored if files system
+// is not namespaced.
+func (s *QuotaFilesSystem) Usage(owner string) UsageReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := UsageReport{
+		Total:       newStorageUsage(s.total, s.limits.MaxBytes, s.limits.MaxFiles),
+		MaxFileSize: s.limits.MaxFileSize,
+	}
+	if s.namespaced {
+		var counter usageCounter
+		if c, ok := s.byOwner[owner]; ok {
+			counter = *c
+		}
+		usage := newStorageUsage(counter, s.limits.MaxBytesPerPrincipal, s.limits.MaxFilesPerPrincipal)
+		report.Principal = &usage
+	}
+
+	return report
+}
+
+// Allowance returns max size of content which can be saved with name now, it is not reserved.
+// ErrQuotaExceeded is returned if file does not exist and new file can not be created.
+func (s *QuotaFilesSystem) Allowance(ctx context.Context, name string) (uint64, error) {
+	var replacedSize int64
+	newFiles := int64(1)
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err == nil {
+		replacedSize, newFiles = int64(info.Size), 0
+	}
+	if err != nil && !errors.Is(err, ErrFileNotFound) {
+		return 0, fmt.Errorf("get info of replaced file: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.checkLimits(s.counter(s.owner(name)), 0, newFiles); err != nil {
+		return 0, err
+	}
+
+	allowance := uint64(math.MaxUint64)
+	if s.limits.MaxFileSize > 0 {
+		allowance = s.limits.MaxFileSize
+	}
+	allowance = minAllowance(allowance, s.total.bytes-replacedSize, s.limits.MaxBytes)
+	if s.namespaced {
+		var used int64
+		if c, ok := s.byOwner[s.owner(name)]; ok {
+			used = c.bytes
+		}
+		allowance = minAllowance(allowance, used-replacedSize, s.limits.MaxBytesPerPrincipal)
+	}
+
+	return allowance, nil
+}
+
+func (s *QuotaFilesSystem) ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error) {
+	return s.filesSystem.ListFilesInfo(ctx, parent)
+}
+
+func (s *QuotaFilesSystem) SaveFile(ctx context.Context, name string, content io.Reader) (uint64, error) {
+	owner := s.owner(name)
+
+	var replacedSize uint64
+	newFiles := int64(1)
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err == nil {
+		replacedSize, newFiles = info.Size, 0
+	}
+	if err != nil && !errors.Is(err, ErrFileNotFound) {
+		return 0, fmt.Errorf("get info of replaced file: %w", err)
+	}
+
+	if err = s.add(owner, 0, newFiles, true); err != nil {
+		return 0, err
+	}
+
+	quotaReader := &quotaReader{
+		filesSystem:  s,
+		owner:        owner,
+		content:      content,
+		replacedSize: replacedSize,
+	}
+
+	size, err := s.filesSystem.SaveFile(ctx, name, quotaReader)
+	if err != nil {
+		_ = s.add(owner, -int64(quotaReader.reserved), -newFiles, false)
+		return 0, err
+	}
+
+	// Reserved bytes are growth of file, actual size replaces size of replaced file.
+	_ = s.add(owner, int64(size)-int64(replacedSize)-int64(quotaReader.reserved), 0, false)
+
+	return size, nil
+}
+
+func (s *QuotaFilesSystem) StatFile(ctx context.Context, name string) (FileInfo, error) {
+	return s.filesSystem.StatFile(ctx, name)
+}
+
+func (s *QuotaFilesSystem) ReadFile(ctx context.Context, name string) (FileInfo, io.ReadSeekCloser, error) {
+	return s.filesSystem.ReadFile(ctx, name)
+}
+
+func (s *QuotaFilesSystem) DeleteFile(ctx context.Context, name string) error {
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if err = s.filesSystem.DeleteFile(ctx, name); err != nil {
+		return err
+	}
+
+	_ = s.add(s.owner(name), -int64(info.Size), -1, false)
+
+	return nil
+}
+
+func (s *QuotaFilesSystem) RenameFile(ctx context.Context, name, newName string) (uint64, error) {
+	owner, newOwner := s.owner(name), s.owner(newName)
+	if owner == newOwner {
+		return s.filesSystem.RenameFile(ctx, name, newName)
+	}
+
+	// File moved to folder of other principal is counted in its quota.
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.transfer(owner, newOwner, int64(info.Size), 1, true); err != nil {
+		return 0, err
+	}
+
+	size, err := s.filesSystem.RenameFile(ctx, name, newName)
+	if err != nil {
+		_ = s.transfer(newOwner, owner, int64(info.Size), 1, false)
+		return 0, err
+	}
+
+	return size, nil
+}
+
+func (s *QuotaFilesSystem) CopyFile(ctx context.Context, name, newName string) (uint64, error) {
+	info, err := s.filesSystem.StatFile(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	newOwner := s.owner(newName)
+	if err = s.add(newOwner, int64(info.Size), 1, true); err != nil {
+		return 0, err
+	}
+
+	size, err := s.filesSystem.CopyFile(ctx, name, newName)
+	if err != nil {
+		_ = s.add(newOwner, -int64(info.Size), -1, false)
+		return 0, err
+	}
+
+	_ = s.add(newOwner, int64(size)-int64(info.Size), 0, false)
+
+	return size, nil
+}
+
+func (s *QuotaFilesSystem) CreateFolder(ctx context.Context, name string) error {
+	return s.filesSystem.CreateFolder(ctx, name)
+}
+
+func (s *QuotaFilesSystem) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	var before usageCounter
+	err := walkFiles(ctx, s.filesSystem, name, func(info FileInfo) {
+		before.bytes += int64(info.Size)
+		before.files++
+	})
+	if err != nil && !errors.Is(err, ErrFolderNotFound) {
+		return fmt.Errorf("count usage of folder: %w", err)
+	}
+
+	deleteErr := s.filesSystem.DeleteFolder(ctx, name, recursive)
+
+	// Folder may be deleted partially, so what is left is counted again.
+	var after usageCounter
+	if deleteErr != nil {
+		err = walkFiles(ctx, s.filesSystem, name, func(info FileInfo) {
+			after.bytes += int64(info.Size)
+			after.files++
+		})
+		if err != nil && !errors.Is(err, ErrFolderNotFound) {
+			return fmt.Errorf("count usage of not deleted folder: %w", err)
+		}
+	}
+
+	_ = s.add(s.owner(name), after.bytes-before.bytes, after.files-before.files, false)
+
+	return deleteErr
+}
+
+// owner returns principal whose folder contains file, it is empty if files system is not namespaced.
+func (s *QuotaFilesSystem) owner(name string) string {
+	if !s.namespaced {
+		return ""
+	}
+	owner, _, _ := strings.Cut(name, "/")
+	return owner
+}
+
+// add changes usage of owner and total usage, growth of usage is checked with limits if check is true.
+func (s *QuotaFilesSystem) add(owner string, bytes, files int64, check bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counter := s.counter(owner)
+
+	if check {
+		if err := s.checkLimits(counter, bytes, files); err != nil {
+			return err
+		}
+	}
+
+	counter.bytes += bytes
+	counter.files += files
+	s.total.bytes += bytes
+	s.total.files += files
+
+	return nil
+}
+
+// transfer moves usage from one owner to other, total usage is not changed.
+func (s *QuotaFilesSystem) transfer(from, to string, bytes, files int64, check bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fromCounter, toCounter := s.counter(from), s.counter(to)
+
+	if check {
+		if err := s.checkOwnerLimits(toCounter, bytes, files); err != nil {
+			return err
+		}
+	}
+
+	fromCounter.bytes -= bytes
+	fromCounter.files -= files
+	toCounter.bytes += bytes
+	toCounter.files += files
+
+	return nil
+}
+
+// checkLimits checks that growth of usage of owner keeps it and total usage in limits.
+func (s *QuotaFilesSystem) checkLimits(counter *usageCounter, bytes, files int64) error {
+	if exceedsLimit(s.total.bytes, bytes, s.limits.MaxBytes) {
+		return fmt.Errorf("%w: storage is limited by %d bytes", ErrQuotaExceeded, s.limits.MaxBytes)
+	}
+	if exceedsLimit(s.total.files, files, s.limits.MaxFiles) {
+		return fmt.Errorf("%w: storage is limited by %d files", ErrQuotaExceeded, s.limits.MaxFiles)
+	}
+	return s.checkOwnerLimits(counter, bytes, files)
+}
+
+func (s *QuotaFilesSystem) checkOwnerLimits(counter *usageCounter, bytes, files int64) error {
+	if !s.namespaced {
+		return nil
+	}
+	if exceedsLimit(counter.bytes, bytes, s.limits.MaxBytesPerPrincipal) {
+		return fmt.Errorf("%w: folder of principal is limited by %d bytes", ErrQuotaExceeded, s.limits.MaxBytesPerPrincipal)
+	}
+	if exceedsLimit(counter.files, files, s.limits.MaxFilesPerPrincipal) {
+		return fmt.Errorf("%w: folder of principal is limited by %d files", ErrQuotaExceeded, s.limits.MaxFilesPerPrincipal)
+	}
+	return nil
+}
+
+func (s *QuotaFilesSystem) counter(owner string) *usageCounter {
+	counter, ok := s.byOwner[owner]
+	if !ok {
+		counter = &usageCounter{}
+		s.byOwner[owner] = counter
+	}
+	return counter
+}
+
+// quotaReader reserves bytes of content which exceed size of replaced file while content is read.
+type quotaReader struct {
+	filesSystem  *QuotaFilesSystem
+	owner        string
+	content      io.Reader
+	replacedSize uint64
+	read         uint64
+	reserved     uint64
+}
+
+func (r *quotaReader) Read(dst []byte) (int, error) {
+	n, err := r.content.Read(dst)
+	r.read += uint64(n)
+
+	if maxFileSize := r.filesSystem.limits.MaxFileSize; maxFileSize > 0 && r.read > maxFileSize {
+		return n, fmt.Errorf("%w: file is larger than %d bytes", ErrQuotaExceeded, maxFileSize)
+	}
+
+	if growth := r.read - r.replacedSize; r.read > r.replacedSize && growth > r.reserved {
+		if reserveErr := r.filesSystem.add(r.owner, int64(growth-r.reserved), 0, true); reserveErr != nil {
+			return n, reserveErr
+		}
+		r.reserved = growth
+	}
+
+	return n, err
+}
+
+// SizeLimitReader fails with ErrQuotaExceeded when content is longer than limit,
+// content over limit is not returned.
+type SizeLimitReader struct {
+	content io.Reader
+	left    uint64
+}
+
+func NewSizeLimitReader(content io.Reader, limit uint64) *SizeLimitReader {
+	return &SizeLimitReader{
+		content: content,
+		left:    limit,
+	}
+}
+
+func (r *SizeLimitReader) Read(dst []byte) (int, error) {
+	n, err := r.content.Read(dst)
+	if uint64(n) > r.left {
+		n = int(r.left)
+		r.left = 0
+		return n, fmt.Errorf("%w: file is larger than allowed", ErrQuotaExceeded)
+	}
+	r.left -= uint64(n)
+
+	return n, err
+}
+
+// walkFiles calls fn for every file in folder and its sub folders.
+func walkFiles(ctx context.Context, filesSystem FilesSystem, parent string, fn func(FileInfo)) error {
+	filesInfo, err := filesSystem.ListFilesInfo(ctx, parent)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range filesInfo {
+		if !info.IsFolder {
+			fn(info)
+			continue
+		}
+		if err = walkFiles(ctx, filesSystem, info.Name, fn); err != nil {
+			return fmt.Errorf("walk folder %s: %w", info.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func exceedsLimit(used, delta int64, limit uint64) bool {
+	return delta > 0 && limit > 0 && used+delta > int64(limit)
+}
+
+// minAllowance returns allowance reduced to what is left of limit after used bytes.
+func minAllowance(allowance uint64, used int64, limit uint64) uint64 {
+	if limit == 0 {
+		return allowance
+	}
+	if used >= int64(limit) {
+		return 0
+	}
+	if left := uint64(int64(limit) - used); left < allowance {
+		return left
+	}
+	return allowance
+}
+
+func newStorageUsage(counter usageCounter, maxBytes, maxFiles uint64) StorageUsage {
+	usage := StorageUsage{
+		MaxBytes: maxBytes,
+		MaxFiles: maxFiles,
+	}
+	if counter.bytes > 0 {
+		usage.Bytes = uint64(counter.bytes)
+	}
+	if counter.files > 0 {
+		usage.Files = uint64(counter.files)
+	}
+	return usage
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func newTestQuotaFilesSystem(t *testing.T, limits StorageLimits, namespaced bool, files map[string]string) *QuotaFilesSystem {
+	t.Helper()
+
+	ctx := context.Background()
+	filesSystem := NewMemoryFileSystem(MemoryFileSystemConfig{})
+	for name, content := range files {
+		if _, err := filesSystem.SaveFile(ctx, name, strings.NewReader(content)); err != nil {
+			t.Fatalf("save file %s: %v", name, err)
+		}
+	}
+
+	quota, err := NewQuotaFilesSystem(ctx, filesSystem, limits, namespaced)
+	if err != nil {
+		t.Fatalf("new quota files system: %v", err)
+	}
+
+	return quota
+}
+
+func TestQuotaFilesSystem_SaveFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		limits     StorageLimits
+		namespaced bool
+		files      map[string]string
+		saveName   string
+		content    string
+		exceeded   bool
+		usage      StorageUsage
+	}{
+		{
+			name:     "in limits",
+			limits:   StorageLimits{MaxBytes: 10, MaxFiles: 2},
+			files:    map[string]string{"a": "12345"},
+			saveName: "b",
+			content:  "12345",
+			usage:    StorageUsage{Bytes: 10, Files: 2, MaxBytes: 10, MaxFiles: 2},
+		},
+		{
+			name:     "bytes exceeded",
+			limits:   StorageLimits{MaxBytes: 10},
+			files:    map[string]string{"a": "12345"},
+			saveName: "b",
+			content:  "123456",
+			exceeded: true,
+			usage:    StorageUsage{Bytes: 5, Files: 1, MaxBytes: 10},
+		},
+		{
+			name:     "files exceeded",
+			limits:   StorageLimits{MaxFiles: 1},
+			files:    map[string]string{"a": "12345"},
+			saveName: "b",
+			content:  "1",
+			exceeded: true,
+			usage:    StorageUsage{Bytes: 5, Files: 1, MaxFiles: 1},
+		},
+		{
+			name:     "replaced file is not counted",
+			limits:   StorageLimits{MaxBytes: 10, MaxFiles: 1},
+			files:    map[string]string{"a": "1234567890"},
+			saveName: "a",
+			content:  "0987654321",
+			usage:    StorageUsage{Bytes: 10, Files: 1, MaxBytes: 10, MaxFiles: 1},
+		},
+		{
+			name:     "max file size exceeded",
+			limits:   StorageLimits{MaxFileSize: 3},
+			saveName: "a",
+			content:  "1234",
+			exceeded: true,
+		},
+		{
+			name:       "principal bytes exceeded",
+			limits:     StorageLimits{MaxBytesPerPrincipal: 5},
+			namespaced: true,
+			files:      map[string]string{"alice/a": "12345", "bob/a": "12345"},
+			saveName:   "alice/b",
+			content:    "1",
+			exceeded:   true,
+			usage:      StorageUsage{Bytes: 10, Files: 2},
+		},
+		{
+			name:       "other principal in limits",
+			limits:     StorageLimits{MaxBytesPerPrincipal: 5},
+			namespaced: true,
+			files:      map[string]string{"alice/a": "12345"},
+			saveName:   "bob/a",
+			content:    "12345",
+			usage:      StorageUsage{Bytes: 10, Files: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			quota := newTestQuotaFilesSystem(t, tt.limits, tt.namespaced, tt.files)
+
+			_, err := quota.SaveFile(ctx, tt.saveName, iotest.OneByteReader(strings.NewReader(tt.content)))
+			if tt.exceeded && !errors.Is(err, ErrQuotaExceeded) {
+				t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+			}
+			if !tt.exceeded && err != nil {
+				t.Fatalf("save file: %v", err)
+			}
+
+			// Usage is same as before failed save, bytes reserved by it are released.
+			if usage := quota.Usage("").Total; usage != tt.usage {
+				t.Fatalf("expected usage %+v, got %+v", tt.usage, usage)
+			}
+		})
+	}
+}
+
+// blockingReader returns content and then blocks until it is released, read is signaled after first read.
+type blockingReader struct {
+	content io.Reader
+	read    chan struct{}
+	release chan struct{}
+	reads   int
+}
+
+func (r *blockingReader) Read(dst []byte) (int, error) {
+	r.reads++
+	if r.reads == 2 {
+		close(r.read)
+		<-r.release
+	}
+	return r.content.Read(dst)
+}
+
+func TestQuotaFilesSystem_SaveFileReservesBytes(t *testing.T) {
+	ctx := context.Background()
+	quota := newTestQuotaFilesSystem(t, StorageLimits{MaxBytes: 10}, false, nil)
+
+	first := &blockingReader{
+		content: strings.NewReader("123456"),
+		read:    make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := quota.SaveFile(ctx, "a", first)
+		firstErr <- err
+	}()
+
+	// Bytes of first save are reserved while it is not finished, so second save does not fit.
+	<-first.read
+	if _, err := quota.SaveFile(ctx, "b", strings.NewReader("123456")); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected ErrQuotaExceeded of concurrent save, got %v", err)
+	}
+
+	close(first.release)
+	if err := <-firstErr; err != nil {
+		t.Fatalf("save first file: %v", err)
+	}
+
+	expected := StorageUsage{Bytes: 6, Files: 1, MaxBytes: 10}
+	if usage := quota.Usage("").Total; usage != expected {
+		t.Fatalf("expected usage %+v, got %+v", expected, usage)
+	}
+}
+
+func TestQuotaFilesSystem_Allowance(t *testing.T) {
+	tests := []struct {
+		name       string
+		limits     StorageLimits
+		namespaced bool
+		files      map[string]string
+		fileName   string
+		allowance  uint64
+		exceeded   bool
+	}{
+		{
+			name:      "unlimited",
+			fileName:  "a",
+			allowance: ^uint64(0),
+		},
+		{
+			name:      "max file size",
+			limits:    StorageLimits{MaxFileSize: 3, MaxBytes: 10},
+			fileName:  "a",
+			allowance: 3,
+		},
+		{
+			name:      "left of max bytes",
+			limits:    StorageLimits{MaxBytes: 10},
+			files:     map[string]string{"a": "1234"},
+			fileName:  "b",
+			allowance: 6,
+		},
+		{
+			name:      "replaced file",
+			limits:    StorageLimits{MaxBytes: 10},
+			files:     map[string]string{"a": "1234"},
+			fileName:  "a",
+			allowance: 10,
+		},
+		{
+			name:       "left of principal bytes",
+			limits:     StorageLimits{MaxBytes: 10, MaxBytesPerPrincipal: 5},
+			namespaced: true,
+			files:      map[string]string{"alice/a": "1234"},
+			fileName:   "alice/b",
+			allowance:  1,
+		},
+		{
+			name:     "files exceeded",
+			limits:   StorageLimits{MaxFiles: 1},
+			files:    map[string]string{"a": "1234"},
+			fileName: "b",
+			exceeded: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quota := newTestQuotaFilesSystem(t, tt.limits, tt.namespaced, tt.files)
+
+			allowance, err := quota.Allowance(context.Background(), tt.fileName)
+			if tt.exceeded {
+				if !errors.Is(err, ErrQuotaExceeded) {
+					t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("get allowance: %v", err)
+			}
+			if allowance != tt.allowance {
+				t.Fatalf("expected allowance %d, got %d", tt.allowance, allowance)
+			}
+		})
+	}
+}
+
+func TestSizeLimitReader(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		limit    uint64
+		exceeded bool
+	}{
+		{name: "shorter", content: "123", limit: 4},
+		{name: "equal", content: "1234", limit: 4},
+		{name: "longer", content: "12345", limit: 4, exceeded: true},
+		{name: "empty", content: "", limit: 0},
+		{name: "zero limit", content: "1", limit: 0, exceeded: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := io.ReadAll(NewSizeLimitReader(strings.NewReader(tt.content), tt.limit))
+			if tt.exceeded {
+				if !errors.Is(err, ErrQuotaExceeded) {
+					t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+				}
+				if uint64(len(content)) > tt.limit {
+					t.Fatalf("content over limit is returned: %q", content)
+				}
+				return
+			}
+			if err != nil || string(content) != tt.content {
+				t.Fatalf("expected content %q, got %q and error %v", tt.content, content, err)
+			}
+		})
+	}
+}
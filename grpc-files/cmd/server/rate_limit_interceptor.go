@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// forwardedForHeader is metadata with addresses of client and proxies, gateway appends address of its client to it.
+const forwardedForHeader = "x-forwarded-for"
+
+// RateLimitInterceptor limits rate of calls by address of client and by authenticated principal. Calls are limited
+// by address before authentication, so flood of unauthenticated calls is rejected cheaply, and by principal after it.
+// Rejected calls get RESOURCE_EXHAUSTED with RetryInfo, calls of health service are not limited.
+type RateLimitInterceptor struct {
+	// byAddress and byPrincipal are nil if calls are not limited by them.
+	byAddress   *KeyedRateLimiter
+	byPrincipal *KeyedRateLimiter
+	// trustedProxies are networks of proxies like gateway, address of client is taken from x-forwarded-for
+	// for calls from them, else all clients of proxy share its limit.
+	trustedProxies []*net.IPNet
+}
+
+func NewRateLimitInterceptor(byAddress, byPrincipal *KeyedRateLimiter, trustedProxies []*net.IPNet) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		byAddress:      byAddress,
+		byPrincipal:    byPrincipal,
+		trustedProxies: trustedProxies,
+	}
+}
+
+// AddressUnary limits unary calls by address of client, it is placed before authentication.
+func (i *RateLimitInterceptor) AddressUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := i.allowAddress(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// AddressStream limits stream calls by address of client, it is placed before authentication.
+func (i *RateLimitInterceptor) AddressStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := i.allowAddress(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// PrincipalUnary limits unary calls by principal, it is placed after authentication.
+func (i *RateLimitInterceptor) PrincipalUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := i.allowPrincipal(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// PrincipalStream limits stream calls by principal, it is placed after authentication.
+func (i *RateLimitInterceptor) PrincipalStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := i.allowPrincipal(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (i *RateLimitInterceptor) allowAddress(ctx context.Context, fullMethod string) error {
+	if i.byAddress == nil || strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return nil
+	}
+
+	if address := i.clientAddress(ctx); address != "" {
+		if ok, retryAfter := i.byAddress.Allow(address); !ok {
+			return rateLimitedError(fmt.Sprintf("too many calls from %s", address), retryAfter)
+		}
+	}
+
+	return nil
+}
+
+func (i *RateLimitInterceptor) allowPrincipal(ctx context.Context, fullMethod string) error {
+	if i.byPrincipal == nil || strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return nil
+	}
+
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		if ok, retryAfter := i.byPrincipal.Allow(principal.ID); !ok {
+			return rateLimitedError(fmt.Sprintf("too many calls of principal %s", principal.ID), retryAfter)
+		}
+	}
+
+	return nil
+}
+
+// clientAddress returns IP of peer, or last address of x-forwarded-for if peer is trusted proxy,
+// which is address of client seen by proxy. Other addresses of header can be forged by client.
+func (i *RateLimitInterceptor) clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if !i.trustedProxy(net.ParseIP(host)) {
+		return host
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwardedFor := md.Get(forwardedForHeader)
+	if len(forwardedFor) == 0 {
+		return host
+	}
+
+	addresses := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+	if client := strings.TrimSpace(addresses[len(addresses)-1]); client != "" {
+		return client
+	}
+
+	return host
+}
+
+func (i *RateLimitInterceptor) trustedProxy(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range i.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitedError is RESOURCE_EXHAUSTED with RetryInfo, gateway sends it as 429 with Retry-After header.
+func rateLimitedError(msg string, retryAfter time.Duration) error {
+	// Client retries in whole seconds, so delay is rounded up.
+	retryAfter = time.Duration(math.Ceil(retryAfter.Seconds())) * time.Second
+
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(
+		&errdetails.ErrorInfo{Reason: "RATE_LIMITED", Domain: files.FilesService_ServiceDesc.ServiceName},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, msg)
+	}
+
+	return st.Err()
+}
+
+// ParseTrustedProxies parses IPs and CIDRs of proxies.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			networks = append(networks, network)
+			continue
+		}
+
+		ip := net.ParseIP(proxy)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid address of trusted proxy %q", proxy)
+		}
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+		networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+
+	return networks, nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerContext returns incoming context of call from address with metadata.
+func peerContext(address string, md metadata.MD) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 40000}})
+	if md != nil {
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	return ctx
+}
+
+func TestRateLimitInterceptor_ClientAddress(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "::1"})
+	if err != nil {
+		t.Fatalf("parse trusted proxies: %v", err)
+	}
+	interceptor := NewRateLimitInterceptor(nil, nil, trustedProxies)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		address string
+	}{
+		{name: "without peer", ctx: context.Background()},
+		{name: "untrusted peer", ctx: peerContext("192.0.2.1", nil), address: "192.0.2.1"},
+		{name: "forged header of untrusted peer", ctx: peerContext("192.0.2.1", metadata.Pairs(forwardedForHeader, "198.51.100.1")), address: "192.0.2.1"},
+		{name: "trusted proxy without header", ctx: peerContext("10.1.2.3", nil), address: "10.1.2.3"},
+		{name: "client of trusted proxy", ctx: peerContext("10.1.2.3", metadata.Pairs(forwardedForHeader, "198.51.100.1")), address: "198.51.100.1"},
+		{name: "client of trusted ipv6 proxy", ctx: peerContext("::1", metadata.Pairs(forwardedForHeader, "198.51.100.1")), address: "198.51.100.1"},
+		// Client prepends forged addresses, proxy appends address of client, so last one is taken.
+		{name: "forged addresses before client", ctx: peerContext("10.1.2.3", metadata.Pairs(forwardedForHeader, "203.0.113.7, 198.51.100.1")), address: "198.51.100.1"},
+		{name: "several headers", ctx: peerContext("10.1.2.3", metadata.Pairs(forwardedForHeader, "203.0.113.7", forwardedForHeader, "198.51.100.1")), address: "198.51.100.1"},
+		{name: "empty last address", ctx: peerContext("10.1.2.3", metadata.Pairs(forwardedForHeader, "198.51.100.1, ")), address: "10.1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := interceptor.clientAddress(tt.ctx); got != tt.address {
+				t.Fatalf("expected address %q, got %q", tt.address, got)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	networks, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1", "::1"})
+	if err != nil {
+		t.Fatalf("parse trusted proxies: %v", err)
+	}
+	if len(networks) != 3 || networks[1].String() != "192.0.2.1/32" || networks[2].String() != "::1/128" {
+		t.Fatalf("unexpected networks %v", networks)
+	}
+
+	if _, err = ParseTrustedProxies([]string{"gateway"}); err == nil {
+		t.Fatalf("expected error of invalid proxy")
+	}
+}
+
+func TestRateLimitInterceptor_Limits(t *testing.T) {
+	interceptor := NewRateLimitInterceptor(NewKeyedRateLimiter(1, 1), NewKeyedRateLimiter(1, 1), nil)
+	filesInfo := &grpc.UnaryServerInfo{FullMethod: "/example.files.v1.FilesService/GetFileHeader"}
+	healthInfo := &grpc.UnaryServerInfo{FullMethod: healthServicePrefix + "Check"}
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+
+	aliceCtx := ContextWithPrincipal(peerContext("192.0.2.1", nil), &Principal{ID: "alice"})
+	bobCtx := ContextWithPrincipal(peerContext("192.0.2.2", nil), &Principal{ID: "bob"})
+
+	tests := []struct {
+		name        string
+		interceptor grpc.UnaryServerInterceptor
+		ctx         context.Context
+		info        *grpc.UnaryServerInfo
+		code        codes.Code
+	}{
+		{name: "first call of address", interceptor: interceptor.AddressUnary, ctx: aliceCtx, info: filesInfo},
+		{name: "second call of address", interceptor: interceptor.AddressUnary, ctx: aliceCtx, info: filesInfo, code: codes.ResourceExhausted},
+		{name: "call of other address", interceptor: interceptor.AddressUnary, ctx: bobCtx, info: filesInfo},
+		{name: "health check of limited address", interceptor: interceptor.AddressUnary, ctx: aliceCtx, info: healthInfo},
+		{name: "first call of principal", interceptor: interceptor.PrincipalUnary, ctx: aliceCtx, info: filesInfo},
+		{name: "second call of principal", interceptor: interceptor.PrincipalUnary, ctx: aliceCtx, info: filesInfo, code: codes.ResourceExhausted},
+		{name: "call of other principal", interceptor: interceptor.PrincipalUnary, ctx: bobCtx, info: filesInfo},
+		{name: "call without principal", interceptor: interceptor.PrincipalUnary, ctx: peerContext("192.0.2.1", nil), info: filesInfo},
+		{name: "health check of limited principal", interceptor: interceptor.PrincipalUnary, ctx: aliceCtx, info: healthInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.interceptor(tt.ctx, nil, tt.info, handler)
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected code %s, got %v", tt.code, err)
+			}
+			if err == nil {
+				return
+			}
+
+			var retryInfo *errdetails.RetryInfo
+			for _, detail := range status.Convert(err).Details() {
+				if info, ok := detail.(*errdetails.RetryInfo); ok {
+					retryInfo = info
+				}
+			}
+			// Delay is rounded up to whole seconds.
+			if retryInfo == nil || retryInfo.GetRetryDelay().AsDuration() != time.Second {
+				t.Fatalf("expected retry info of second, got %v", retryInfo)
+			}
+		})
+	}
+}
+
+func TestRateLimitInterceptor_AddressBeforeAuthentication(t *testing.T) {
+	authenticator, err := NewAPIKeyAuthenticator(map[string]Principal{"alice-key": {ID: "alice"}})
+	if err != nil {
+		t.Fatalf("new api key authenticator: %v", err)
+	}
+	authInterceptor := NewAuthInterceptor(map[string]Authenticator{apiKeyAuthScheme: authenticator}, nil)
+	rateLimitInterceptor := NewRateLimitInterceptor(NewKeyedRateLimiter(1, 2), nil, nil)
+
+	// Interceptors are chained like in main.
+	info := &grpc.UnaryServerInfo{FullMethod: "/example.files.v1.FilesService/GetFileHeader"}
+	call := func(key string) error {
+		ctx := peerContext("192.0.2.1", metadata.Pairs(authorizationHeader, apiKeyAuthScheme+" "+key))
+		_, err := rateLimitInterceptor.AddressUnary(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authInterceptor.Unary(ctx, req, info, func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+		})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err = call("forged-key"); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	}
+	// Calls with bad credentials use limit of address.
+	if err = call("forged-key"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if err = call("alice-key"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted of valid key from limited address, got %v", err)
+	}
+}
@@ -0,0 +1,391 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	defaultS3PartSize = 16 << 20
+	// maxS3CopyObjectSize is limit of object size for single copy request.
+	maxS3CopyObjectSize = 5 << 30
+)
+
+var _ FilesSystem = (*S3FileSystem)(nil)
+
+type S3Config struct {
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	Region          string
+	Bucket          string
+	// Prefix is prepended to keys of all objects, so one bucket may be shared by several services.
+	Prefix string
+	UseSSL bool
+	// PartSize is size of part of multipart upload, content of part is buffered in memory.
+	PartSize uint64
+}
+
+// S3FileSystem keeps files as objects of S3 compatible storage. Folders are prefixes of keys,
+// empty folder is kept as zero size object with key ending by slash.
+type S3FileSystem struct {
+	client   *minio.Client
+	bucket   string
+	prefix   string
+	partSize uint64
+	// maxCopyObjectSize is maxS3CopyObjectSize, larger objects are composed by parts.
+	maxCopyObjectSize int64
+}
+
+func MustNewS3FileSystem(ctx context.Context, config S3Config) *S3FileSystem {
+	s3fs, err := NewS3FileSystem(ctx, config)
+	if err != nil {
+		panic(fmt.Errorf("fatal init s3 file system: %w", err))
+	}
+	return s3fs
+}
+
+func NewS3FileSystem(ctx context.Context, config S3Config) (*S3FileSystem, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.New("endpoint and bucket are required")
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKeyID, config.SecretAccessKey, ""),
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("check bucket: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("bucket %s does not exist", config.Bucket)
+	}
+
+	s3fs := S3FileSystem{
+		client:   client,
+		bucket:   config.Bucket,
+		prefix:   strings.Trim(config.Prefix, "/"),
+		partSize: config.PartSize,
+
+		maxCopyObjectSize: maxS3CopyObjectSize,
+	}
+	if s3fs.prefix != "" {
+		s3fs.prefix += "/"
+	}
+	if s3fs.partSize == 0 {
+		s3fs.partSize = defaultS3PartSize
+	}
+
+	return &s3fs, nil
+}
+
+func (s *S3FileSystem) ListFilesInfo(ctx context.Context, parent string) ([]FileInfo, error) {
+	exists, err := s.folderExists(ctx, parent)
+	if err != nil {
+		return nil, fmt.Errorf("check folder: %w", err)
+	}
+	if !exists {
+		return nil, ErrFolderNotFound
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listPrefix := s.folderKey(parent)
+	filesInfo := make([]FileInfo, 0)
+	// Some storages return folder both as common prefix and as marker object.
+	listedFolders := make(map[string]struct{})
+
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: listPrefix}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("list objects: %w", object.Err)
+		}
+
+		name := strings.TrimPrefix(object.Key, listPrefix)
+		// Empty name is marker of listed folder itself.
+		if name == "" {
+			continue
+		}
+
+		isFolder := strings.HasSuffix(name, "/")
+		name = strings.TrimSuffix(name, "/")
+
+		// Hidden objects are not available for clients as hidden files of LocalFileSystem.
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		if isFolder {
+			if _, ok := listedFolders[name]; ok {
+				continue
+			}
+			listedFolders[name] = struct{}{}
+		}
+
+		info := FileInfo{
+			Name:       path.Join(parent, name),
+			ModifiedAt: object.LastModified.UTC(),
+			IsFolder:   isFolder,
+		}
+		if !isFolder {
+			info.Size = uint64(object.Size)
+		}
+
+		filesInfo = append(filesInfo, info)
+	}
+
+	return filesInfo, nil
+}
+
+// SaveFile uploads content by multipart upload, because size of content is not known before it is read.
+func (s *S3FileSystem) SaveFile(ctx context.Context, name string, content io.Reader) (uint64, error) {
+	info, err := s.client.PutObject(ctx, s.bucket, s.fileKey(name), NewContextReader(ctx, content), -1, minio.PutObjectOptions{
+		PartSize: s.partSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("put object: %w", err)
+	}
+
+	return uint64(info.Size), nil
+}
+
+func (s *S3FileSystem) StatFile(ctx context.Context, name string) (FileInfo, error) {
+	info, err := s.statFile(ctx, name)
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	return newS3FileInfo(name, info), nil
+}
+
+// ReadFile returns object which reads content by ranged GET requests from current position, so seek is cheap.
+func (s *S3FileSystem) ReadFile(ctx context.Context, name string) (info FileInfo, content io.ReadSeekCloser, err error) {
+	objectInfo, err := s.statFile(ctx, name)
+	if errors.Is(err, ErrFileNotFound) {
+		return FileInfo{}, nil, nil
+	}
+	if err != nil {
+		return FileInfo{}, nil, err
+	}
+
+	// Object may be replaced after stat, so content is read only from version of object with known size.
+	opts := minio.GetObjectOptions{}
+	if err = opts.SetMatchETag(objectInfo.ETag); err != nil {
+		return FileInfo{}, nil, fmt.Errorf("set etag condition: %w", err)
+	}
+
+	object, err := s.client.GetObject(ctx, s.bucket, s.fileKey(name), opts)
+	if err != nil {
+		return FileInfo{}, nil, fmt.Errorf("get object: %w", err)
+	}
+
+	return newS3FileInfo(name, objectInfo), object, nil
+}
+
+func (s *S3FileSystem) DeleteFile(ctx context.Context, name string) error {
+	if _, err := s.statFile(ctx, name); err != nil {
+		return err
+	}
+
+	if err := s.client.RemoveObject(ctx, s.bucket, s.fileKey(name), minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove object: %w", err)
+	}
+
+	return nil
+}
+
+// RenameFile copies object to new key and removes old one, S3 does not have atomic rename.
+func (s *S3FileSystem) RenameFile(ctx context.Context, name, newName string) (uint64, error) {
+	size, err := s.CopyFile(ctx, name, newName)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.client.RemoveObject(ctx, s.bucket, s.fileKey(name), minio.RemoveObjectOptions{}); err != nil {
+		return 0, fmt.Errorf("remove object with old name: %w", err)
+	}
+
+	return size, nil
+}
+
+func (s *S3FileSystem) CopyFile(ctx context.Context, name, newName string) (uint64, error) {
+	info, err := s.statFile(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	// Check is not atomic with copy, but S3 does not have conditional copy.
+	_, err = s.statFile(ctx, newName)
+	if err == nil {
+		return 0, ErrFileAlreadyExists
+	}
+	if !errors.Is(err, ErrFileNotFound) {
+		return 0, fmt.Errorf("check new name: %w", err)
+	}
+
+	dst := minio.CopyDestOptions{Bucket: s.bucket, Object: s.fileKey(newName)}
+	src := minio.CopySrcOptions{Bucket: s.bucket, Object: s.fileKey(name), MatchETag: info.ETag}
+
+	if info.Size <= s.maxCopyObjectSize {
+		_, err = s.client.CopyObject(ctx, dst, src)
+	} else {
+		// Compose copies large object by parts.
+		_, err = s.client.ComposeObject(ctx, dst, src)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("copy object: %w", err)
+	}
+
+	return uint64(info.Size), nil
+}
+
+func (s *S3FileSystem) CreateFolder(ctx context.Context, name string) error {
+	_, err := s.statFile(ctx, name)
+	if err == nil {
+		return ErrFileAlreadyExists
+	}
+	if !errors.Is(err, ErrFileNotFound) {
+		return fmt.Errorf("check file with name of folder: %w", err)
+	}
+
+	// Markers of parent folders are created too, so they are not lost when folder is deleted.
+	for folder := name; folder != "."; folder = path.Dir(folder) {
+		_, err = s.client.PutObject(ctx, s.bucket, s.folderKey(folder), strings.NewReader(""), 0, minio.PutObjectOptions{})
+		if err != nil {
+			return fmt.Errorf("put marker of folder %s: %w", folder, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *S3FileSystem) DeleteFolder(ctx context.Context, name string, recursive bool) error {
+	if name == "" {
+		return fmt.Errorf("%w: root folder can not be deleted", ErrInvalidArgument)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	folderKey := s.folderKey(name)
+	found := false
+
+	// Folder is empty if it has only marker.
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: folderKey, MaxKeys: 2}) {
+		if object.Err != nil {
+			return fmt.Errorf("list objects of folder: %w", object.Err)
+		}
+		found = true
+		if recursive {
+			break
+		}
+		if object.Key != folderKey {
+			return ErrFolderNotEmpty
+		}
+	}
+	if !found {
+		return ErrFolderNotFound
+	}
+
+	var listErr error
+	objects := make(chan minio.ObjectInfo)
+
+	go func() {
+		defer close(objects)
+
+		for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: folderKey, Recursive: true}) {
+			if object.Err != nil {
+				listErr = object.Err
+				return
+			}
+
+			select {
+			case objects <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for removeErr := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		cancel()
+		return fmt.Errorf("remove object %s: %w", removeErr.ObjectName, removeErr.Err)
+	}
+	if listErr != nil {
+		return fmt.Errorf("list objects of folder: %w", listErr)
+	}
+
+	return nil
+}
+
+func (s *S3FileSystem) statFile(ctx context.Context, name string) (minio.ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, s.fileKey(name), minio.StatObjectOptions{})
+	if isS3NotFound(err) {
+		return minio.ObjectInfo{}, ErrFileNotFound
+	}
+	if err != nil {
+		return minio.ObjectInfo{}, fmt.Errorf("stat object: %w", err)
+	}
+
+	return info, nil
+}
+
+// folderExists checks marker of folder, or any object inside of folder created without marker.
+func (s *S3FileSystem) folderExists(ctx context.Context, name string) (bool, error) {
+	if name == "" {
+		return true, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.folderKey(name), MaxKeys: 1}) {
+		if object.Err != nil {
+			return false, fmt.Errorf("list objects of folder: %w", object.Err)
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (s *S3FileSystem) fileKey(name string) string {
+	return s.prefix + name
+}
+
+func (s *S3FileSystem) folderKey(name string) string {
+	if name == "" {
+		return s.prefix
+	}
+	return s.prefix + name + "/"
+}
+
+func newS3FileInfo(name string, info minio.ObjectInfo) FileInfo {
+	return FileInfo{
+		Name:       name,
+		Size:       uint64(info.Size),
+		ModifiedAt: info.LastModified.UTC(),
+	}
+}
+
+func isS3NotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	resp := minio.ToErrorResponse(err)
+	return resp.Code == "NoSuchKey" || resp.StatusCode == http.StatusNotFound
+}
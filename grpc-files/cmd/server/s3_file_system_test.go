@@ -0,0 +1,690 @@
+package main
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+type fakeS3Object struct {
+	content    []byte
+	etag       string
+	modifiedAt time.Time
+}
+
+// fakeS3Server is in process stand-in of S3 compatible storage. It serves one bucket without authentication
+// and supports only requests which are made by S3FileSystem.
+type fakeS3Server struct {
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string]fakeS3Object
+	uploads map[string]map[int][]byte
+	nextID  int
+	// partCopies is count of upload part copy requests, they are made only by compose of object.
+	partCopies int
+}
+
+type fakeS3Error struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string
+	Message    string
+	BucketName string
+	Key        string
+}
+
+type fakeS3Content struct {
+	Key          string
+	LastModified time.Time
+	ETag         string
+	Size         int
+}
+
+type fakeS3ListResult struct {
+	XMLName        xml.Name `xml:"ListBucketResult"`
+	Name           string
+	Prefix         string
+	Delimiter      string
+	KeyCount       int
+	MaxKeys        int
+	IsTruncated    bool
+	Contents       []fakeS3Content
+	CommonPrefixes []struct{ Prefix string }
+}
+
+type fakeS3Delete struct {
+	Objects []struct{ Key string } `xml:"Object"`
+}
+
+type fakeS3CompleteUpload struct {
+	Parts []struct{ PartNumber int } `xml:"Part"`
+}
+
+type fakeS3CopyResult struct {
+	LastModified time.Time
+	ETag         string
+}
+
+func newFakeS3FileSystem(t *testing.T, prefix string) (*S3FileSystem, *fakeS3Server) {
+	t.Helper()
+
+	server := &fakeS3Server{
+		bucket:  "files",
+		objects: make(map[string]fakeS3Object),
+		uploads: make(map[string]map[int][]byte),
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	s3fs, err := NewS3FileSystem(context.Background(), S3Config{
+		Endpoint: strings.TrimPrefix(httpServer.URL, "http://"),
+		Region:   "us-east-1",
+		Bucket:   server.bucket,
+		Prefix:   prefix,
+	})
+	if err != nil {
+		t.Fatalf("create s3 file system: %v", err)
+	}
+
+	return s3fs, server
+}
+
+func (s *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "IncompleteBody")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != s.bucket {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case key == "" && r.Method == http.MethodGet:
+		s.listObjects(w, query)
+	case key == "" && r.Method == http.MethodPost && query.Has("delete"):
+		s.deleteObjects(w, r, body)
+	case key == "":
+		s.writeError(w, r, http.StatusNotImplemented, "NotImplemented")
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		s.getObject(w, r, key)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextID++
+		uploadID = strconv.Itoa(s.nextID)
+		s.uploads[uploadID] = make(map[int][]byte)
+		s.writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: uploadID})
+	case r.Method == http.MethodPost && uploadID != "":
+		s.completeUpload(w, r, key, uploadID, body)
+	case r.Method == http.MethodPut && uploadID != "":
+		s.uploadPart(w, r, uploadID, query.Get("partNumber"), body)
+	case r.Method == http.MethodPut && r.Header.Get("x-amz-copy-source") != "":
+		source, ok := s.copySource(w, r)
+		if !ok {
+			return
+		}
+		object := s.put(key, source)
+		s.writeXML(w, struct {
+			XMLName xml.Name `xml:"CopyObjectResult"`
+			fakeS3CopyResult
+		}{fakeS3CopyResult: fakeS3CopyResult{LastModified: object.modifiedAt, ETag: strconv.Quote(object.etag)}})
+	case r.Method == http.MethodPut:
+		object := s.put(key, body)
+		w.Header().Set("ETag", strconv.Quote(object.etag))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(s.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeError(w, r, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *fakeS3Server) listObjects(w http.ResponseWriter, query url.Values) {
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	result := fakeS3ListResult{Name: s.bucket, Prefix: prefix, Delimiter: delimiter, MaxKeys: 1000}
+
+	listedPrefixes := make(map[string]struct{})
+	for _, key := range s.keys() {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if i := strings.Index(key[len(prefix):], delimiter); delimiter != "" && i >= 0 {
+			commonPrefix := key[:len(prefix)+i+len(delimiter)]
+			if _, ok := listedPrefixes[commonPrefix]; !ok {
+				listedPrefixes[commonPrefix] = struct{}{}
+				result.CommonPrefixes = append(result.CommonPrefixes, struct{ Prefix string }{commonPrefix})
+			}
+			continue
+		}
+
+		object := s.objects[key]
+		result.Contents = append(result.Contents, fakeS3Content{
+			Key:          key,
+			LastModified: object.modifiedAt,
+			ETag:         strconv.Quote(object.etag),
+			Size:         len(object.content),
+		})
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+
+	s.writeXML(w, result)
+}
+
+func (s *fakeS3Server) deleteObjects(w http.ResponseWriter, r *http.Request, body []byte) {
+	var request fakeS3Delete
+	if err := xml.Unmarshal(body, &request); err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+	for _, object := range request.Objects {
+		delete(s.objects, object.Key)
+	}
+
+	s.writeXML(w, struct {
+		XMLName xml.Name `xml:"DeleteResult"`
+	}{})
+}
+
+func (s *fakeS3Server) getObject(w http.ResponseWriter, r *http.Request, key string) {
+	object, ok := s.objects[key]
+	if !ok {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+	if match := r.Header.Get("If-Match"); match != "" && strings.Trim(match, `"`) != object.etag {
+		s.writeError(w, r, http.StatusPreconditionFailed, "PreconditionFailed")
+		return
+	}
+
+	content, status := object.content, http.StatusOK
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+		start, end, ok := parseFakeS3Range(rangeHeader, len(content))
+		if !ok {
+			s.writeError(w, r, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+		content, status = content[start:end+1], http.StatusPartialContent
+	}
+
+	w.Header().Set("ETag", strconv.Quote(object.etag))
+	w.Header().Set("Last-Modified", object.modifiedAt.Format(http.TimeFormat))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(content)
+	}
+}
+
+func (s *fakeS3Server) uploadPart(w http.ResponseWriter, r *http.Request, uploadID, partNumber string, body []byte) {
+	parts, ok := s.uploads[uploadID]
+	number, err := strconv.Atoi(partNumber)
+	if !ok || err != nil {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+
+	if r.Header.Get("x-amz-copy-source") == "" {
+		parts[number] = body
+		w.Header().Set("ETag", strconv.Quote(fakeS3ETag(body)))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	source, ok := s.copySource(w, r)
+	if !ok {
+		return
+	}
+	start, end, ok := parseFakeS3Range(r.Header.Get("x-amz-copy-source-range"), len(source))
+	if !ok {
+		s.writeError(w, r, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+		return
+	}
+	parts[number] = source[start : end+1]
+	s.partCopies++
+
+	s.writeXML(w, struct {
+		XMLName xml.Name `xml:"CopyPartResult"`
+		fakeS3CopyResult
+	}{fakeS3CopyResult: fakeS3CopyResult{LastModified: time.Now().UTC(), ETag: strconv.Quote(fakeS3ETag(parts[number]))}})
+}
+
+func (s *fakeS3Server) completeUpload(w http.ResponseWriter, r *http.Request, key, uploadID string, body []byte) {
+	parts, ok := s.uploads[uploadID]
+	if !ok {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+
+	var request fakeS3CompleteUpload
+	if err := xml.Unmarshal(body, &request); err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+
+	var content []byte
+	for _, part := range request.Parts {
+		partContent, ok := parts[part.PartNumber]
+		if !ok {
+			s.writeError(w, r, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		content = append(content, partContent...)
+	}
+	delete(s.uploads, uploadID)
+	object := s.put(key, content)
+
+	s.writeXML(w, struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Bucket  string
+		Key     string
+		ETag    string
+	}{Bucket: s.bucket, Key: key, ETag: strconv.Quote(object.etag)})
+}
+
+// copySource returns content of source object of copy request, if it matches condition of request.
+func (s *fakeS3Server) copySource(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	source, err := url.PathUnescape(r.Header.Get("x-amz-copy-source"))
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+	object, ok := s.objects[key]
+	if err != nil || bucket != s.bucket || !ok {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchKey")
+		return nil, false
+	}
+	if match := r.Header.Get("x-amz-copy-source-if-match"); match != "" && strings.Trim(match, `"`) != object.etag {
+		s.writeError(w, r, http.StatusPreconditionFailed, "PreconditionFailed")
+		return nil, false
+	}
+
+	return object.content, true
+}
+
+func (s *fakeS3Server) put(key string, content []byte) fakeS3Object {
+	object := fakeS3Object{
+		content:    append([]byte(nil), content...),
+		etag:       fakeS3ETag(content),
+		modifiedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	s.objects[key] = object
+	return object
+}
+
+// Put stores object bypassing S3FileSystem.
+func (s *fakeS3Server) Put(key, content string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(key, []byte(content))
+}
+
+// Keys returns sorted keys of all objects.
+func (s *fakeS3Server) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.keys()
+}
+
+func (s *fakeS3Server) PartCopies() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.partCopies
+}
+
+func (s *fakeS3Server) keys() []string {
+	keys := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *fakeS3Server) writeXML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func (s *fakeS3Server) writeError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_ = xml.NewEncoder(w).Encode(fakeS3Error{Code: code, Message: code, BucketName: s.bucket, Key: r.URL.Path})
+	}
+}
+
+func fakeS3ETag(content []byte) string {
+	sum := md5.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// parseFakeS3Range parses single range of form bytes=start-end or bytes=start-, end is inclusive.
+func parseFakeS3Range(header string, size int) (start, end int, ok bool) {
+	startValue, endValue, ok := strings.Cut(strings.TrimPrefix(header, "bytes="), "-")
+	if !ok {
+		return 0, 0, false
+	}
+
+	start, err := strconv.Atoi(startValue)
+	if err != nil {
+		return 0, 0, false
+	}
+	end = size - 1
+	if endValue != "" {
+		if end, err = strconv.Atoi(endValue); err != nil {
+			return 0, 0, false
+		}
+	}
+	if end >= size {
+		end = size - 1
+	}
+
+	return start, end, start <= end
+}
+
+func readS3File(t *testing.T, s3fs *S3FileSystem, name string) string {
+	t.Helper()
+
+	_, content, err := s3fs.ReadFile(context.Background(), name)
+	if err != nil || content == nil {
+		t.Fatalf("read file %s: %v", name, err)
+	}
+	defer content.Close()
+
+	b, err := io.ReadAll(content)
+	if err != nil {
+		t.Fatalf("read content of %s: %v", name, err)
+	}
+	return string(b)
+}
+
+func TestS3FileSystem_SaveAndReadFile(t *testing.T) {
+	ctx := context.Background()
+	s3fs, server := newFakeS3FileSystem(t, "service")
+
+	size, err := s3fs.SaveFile(ctx, "folder/file.txt", strings.NewReader("content"))
+	if err != nil || size != uint64(len("content")) {
+		t.Fatalf("save file: size %d, error %v", size, err)
+	}
+	if keys := strings.Join(server.Keys(), ","); keys != "service/folder/file.txt" {
+		t.Fatalf("unexpected keys %s", keys)
+	}
+
+	info, content, err := s3fs.ReadFile(ctx, "folder/file.txt")
+	if err != nil || content == nil {
+		t.Fatalf("read file: %v", err)
+	}
+	if info.Name != "folder/file.txt" || info.Size != uint64(len("content")) || info.IsFolder {
+		t.Fatalf("unexpected info %+v", info)
+	}
+
+	// Seek reads content from position.
+	if _, err = content.Seek(3, io.SeekStart); err != nil {
+		t.Fatalf("seek: %v", err)
+	}
+	if b, err := io.ReadAll(content); err != nil || string(b) != "tent" {
+		t.Fatalf("read after seek: %q, %v", b, err)
+	}
+	content.Close()
+
+	_, content, err = s3fs.ReadFile(ctx, "missing.txt")
+	if err != nil || content != nil {
+		t.Fatalf("expected no content of missing file, got %v", err)
+	}
+	if _, err = s3fs.StatFile(ctx, "missing.txt"); !errors.Is(err, ErrFileNotFound) {
+		t.Fatalf("expected ErrFileNotFound, got %v", err)
+	}
+}
+
+func TestS3FileSystem_ReadFileReplaced(t *testing.T) {
+	ctx := context.Background()
+	s3fs, _ := newFakeS3FileSystem(t, "")
+
+	if _, err := s3fs.SaveFile(ctx, "file.txt", strings.NewReader("old")); err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+
+	_, content, err := s3fs.ReadFile(ctx, "file.txt")
+	if err != nil || content == nil {
+		t.Fatalf("read file: %v", err)
+	}
+	defer content.Close()
+
+	if _, err = s3fs.SaveFile(ctx, "file.txt", strings.NewReader("new content")); err != nil {
+		t.Fatalf("replace file: %v", err)
+	}
+
+	// Content of replaced object is not mixed with content of stated one.
+	_, err = io.ReadAll(content)
+	if code := minio.ToErrorResponse(err).Code; code != "PreconditionFailed" {
+		t.Fatalf("expected PreconditionFailed, got %v", err)
+	}
+
+	if got := readS3File(t, s3fs, "file.txt"); got != "new content" {
+		t.Fatalf("unexpected content %q", got)
+	}
+}
+
+func TestS3FileSystem_CopyFile(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxCopyObjectSize int64
+		partCopies        int
+	}{
+		{name: "copy object", maxCopyObjectSize: maxS3CopyObjectSize},
+		{name: "compose object", maxCopyObjectSize: 1, partCopies: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s3fs, server := newFakeS3FileSystem(t, "service")
+			s3fs.maxCopyObjectSize = tt.maxCopyObjectSize
+
+			for name, content := range map[string]string{"file.txt": "content", "taken.txt": "taken"} {
+				if _, err := s3fs.SaveFile(ctx, name, strings.NewReader(content)); err != nil {
+					t.Fatalf("save file: %v", err)
+				}
+			}
+
+			size, err := s3fs.CopyFile(ctx, "file.txt", "folder/copy.txt")
+			if err != nil || size != uint64(len("content")) {
+				t.Fatalf("copy file: size %d, error %v", size, err)
+			}
+			if got := readS3File(t, s3fs, "folder/copy.txt"); got != "content" {
+				t.Fatalf("unexpected content of copy %q", got)
+			}
+			if partCopies := server.PartCopies(); partCopies != tt.partCopies {
+				t.Fatalf("expected %d part copies, got %d", tt.partCopies, partCopies)
+			}
+
+			if _, err = s3fs.CopyFile(ctx, "file.txt", "taken.txt"); !errors.Is(err, ErrFileAlreadyExists) {
+				t.Fatalf("expected ErrFileAlreadyExists, got %v", err)
+			}
+			if _, err = s3fs.CopyFile(ctx, "missing.txt", "new.txt"); !errors.Is(err, ErrFileNotFound) {
+				t.Fatalf("expected ErrFileNotFound, got %v", err)
+			}
+
+			if _, err = s3fs.RenameFile(ctx, "folder/copy.txt", "renamed.txt"); err != nil {
+				t.Fatalf("rename file: %v", err)
+			}
+			keys := strings.Join(server.Keys(), ",")
+			if keys != "service/file.txt,service/renamed.txt,service/taken.txt" {
+				t.Fatalf("unexpected keys after rename %s", keys)
+			}
+		})
+	}
+}
+
+func TestS3FileSystem_DeleteFolder(t *testing.T) {
+	tests := []struct {
+		name      string
+		keys      []string
+		folder    string
+		recursive bool
+		err       error
+		keptKeys  string
+	}{
+		{
+			name:     "empty with marker",
+			keys:     []string{"service/a/", "service/ab.txt"},
+			folder:   "a",
+			keptKeys: "service/ab.txt",
+		},
+		{
+			name:     "not empty with marker",
+			keys:     []string{"service/a/", "service/a/b.txt"},
+			folder:   "a",
+			err:      ErrFolderNotEmpty,
+			keptKeys: "service/a/,service/a/b.txt",
+		},
+		{
+			name:     "not empty without marker",
+			keys:     []string{"service/a/b/c.txt"},
+			folder:   "a",
+			err:      ErrFolderNotEmpty,
+			keptKeys: "service/a/b/c.txt",
+		},
+		{
+			name:      "recursive with marker",
+			keys:      []string{"service/a/", "service/a/b/", "service/a/b/c.txt", "service/a.txt", "other/a/d.txt"},
+			folder:    "a",
+			recursive: true,
+			keptKeys:  "other/a/d.txt,service/a.txt",
+		},
+		{
+			name:      "recursive without marker",
+			keys:      []string{"service/a/b/c.txt", "service/a/d.txt", "service/b/a/e.txt"},
+			folder:    "a",
+			recursive: true,
+			keptKeys:  "service/b/a/e.txt",
+		},
+		{
+			name:      "not found",
+			keys:      []string{"service/a.txt"},
+			folder:    "a",
+			recursive: true,
+			err:       ErrFolderNotFound,
+			keptKeys:  "service/a.txt",
+		},
+		{
+			name:      "root",
+			keys:      []string{"service/a.txt"},
+			folder:    "",
+			recursive: true,
+			err:       ErrInvalidArgument,
+			keptKeys:  "service/a.txt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s3fs, server := newFakeS3FileSystem(t, "service")
+			for _, key := range tt.keys {
+				server.Put(key, "")
+			}
+
+			err := s3fs.DeleteFolder(context.Background(), tt.folder, tt.recursive)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+			} else if err != nil {
+				t.Fatalf("delete folder: %v", err)
+			}
+
+			if keys := strings.Join(server.Keys(), ","); keys != tt.keptKeys {
+				t.Fatalf("expected keys %s, got %s", tt.keptKeys, keys)
+			}
+		})
+	}
+}
+
+func TestS3FileSystem_ListFilesInfo(t *testing.T) {
+	ctx := context.Background()
+	s3fs, server := newFakeS3FileSystem(t, "service")
+
+	for _, name := range []string{"a.txt", "implicit/b.txt", "folder/c.txt", ".hidden"} {
+		if _, err := s3fs.SaveFile(ctx, name, strings.NewReader(name)); err != nil {
+			t.Fatalf("save file: %v", err)
+		}
+	}
+	if err := s3fs.CreateFolder(ctx, "folder/empty"); err != nil {
+		t.Fatalf("create folder: %v", err)
+	}
+	server.Put("other/d.txt", "other")
+
+	tests := []struct {
+		parent string
+		err    error
+		files  string
+	}{
+		{parent: "", files: "a.txt:5,folder/,implicit/"},
+		{parent: "folder", files: "folder/c.txt:12,folder/empty/"},
+		{parent: "folder/empty", files: ""},
+		{parent: "implicit", files: "implicit/b.txt:14"},
+		{parent: "missing", err: ErrFolderNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.parent, func(t *testing.T) {
+			infos, err := s3fs.ListFilesInfo(ctx, tt.parent)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("list files: %v", err)
+			}
+
+			files := make([]string, 0, len(infos))
+			for _, info := range infos {
+				if info.IsFolder {
+					files = append(files, info.Name+"/")
+				} else {
+					files = append(files, info.Name+":"+strconv.FormatUint(info.Size, 10))
+				}
+			}
+			sort.Strings(files)
+			if got := strings.Join(files, ","); got != tt.files {
+				t.Fatalf("expected files %s, got %s", tt.files, got)
+			}
+		})
+	}
+}
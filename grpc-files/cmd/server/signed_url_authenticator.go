@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/signedurl"
+)
+
+var _ Authenticator = (*SignedURLAuthenticator)(nil)
+
+// fullMethodsByHTTPMethod are gRPC methods which gateway calls for request by signed URL.
+var fullMethodsByHTTPMethod = map[string][]string{
+	"GET": {
+		"/example.files.v1.FilesService/DownloadFile",
+		"/example.files.v1.FilesService/GetFileHeader",
+	},
+	"POST": {
+		"/example.files.v1.FilesService/UploadFile",
+	},
+}
+
+// SignedURLAuthenticator authenticates gateway requests by signed URL, which gateway passes as credentials.
+// Principal who created URL is limited by method and file of URL.
+type SignedURLAuthenticator struct {
+	signer *signedurl.Signer
+}
+
+func NewSignedURLAuthenticator(signer *signedurl.Signer) *SignedURLAuthenticator {
+	return &SignedURLAuthenticator{signer: signer}
+}
+
+func (a *SignedURLAuthenticator) Authenticate(_ context.Context, credentials string) (*Principal, error) {
+	claims, err := a.signer.Verify(credentials)
+	if errors.Is(err, signedurl.ErrInvalidSignature) || errors.Is(err, signedurl.ErrExpired) {
+		return nil, fmt.Errorf("%w: %s", ErrUnauthenticated, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("verify signed url: %w", err)
+	}
+
+	if err = ValidatePrincipalID(claims.Principal); err != nil {
+		return nil, fmt.Errorf("%w: invalid principal of signed url: %s", ErrUnauthenticated, err)
+	}
+
+	return &Principal{
+		ID: claims.Principal,
+		Scope: &PrincipalScope{
+			FullMethods: fullMethodsByHTTPMethod[claims.Method],
+			Name:        claims.Name,
+		},
+	}, nil
+}
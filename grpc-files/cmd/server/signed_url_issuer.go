@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/internal/signedurl"
+)
+
+const (
+	defaultSignedURLLifetime = 15 * time.Minute
+	maxSignedURLLifetime     = 7 * 24 * time.Hour
+)
+
+// SignedURLIssuer creates URLs of gateway, which are verified by gateway and SignedURLAuthenticator.
+type SignedURLIssuer struct {
+	signer *signedurl.Signer
+	// baseURL is external URL of gateway, for example "https://files.example.com".
+	baseURL string
+}
+
+func NewSignedURLIssuer(signer *signedurl.Signer, baseURL string) (*SignedURLIssuer, error) {
+	if _, err := url.Parse(baseURL); err != nil {
+		return nil, fmt.Errorf("parse base url: %w", err)
+	}
+
+	return &SignedURLIssuer{
+		signer:  signer,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+// Issue returns URL of download of file for GET and URL of upload for POST.
+func (i *SignedURLIssuer) Issue(principal, method, name string, expiresAt time.Time) (string, error) {
+	token, err := i.signer.Sign(signedurl.Claims{
+		Method:    method,
+		Name:      name,
+		Principal: principal,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("sign url: %w", err)
+	}
+
+	query := url.Values{signedurl.QueryParam: {token}}.Encode()
+
+	if method == "POST" {
+		return i.baseURL + "/v1/files?" + query, nil
+	}
+
+	segments := strings.Split(name, "/")
+	for j := range segments {
+		segments[j] = url.PathEscape(segments[j])
+	}
+
+	return i.baseURL + "/v1/files/" + strings.Join(segments, "/") + "?" + query, nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// StorageHealthChecker reports files service as serving to health server while root folder of files system
+// can be listed, so server is not ready when its storage backend is not available.
+type StorageHealthChecker struct {
+	filesSystem FilesSystem
+	health      *health.Server
+	period      time.Duration
+	timeout     time.Duration
+}
+
+func NewStorageHealthChecker(filesSystem FilesSystem, health *health.Server, period, timeout time.Duration) *StorageHealthChecker {
+	return &StorageHealthChecker{
+		filesSystem: filesSystem,
+		health:      health,
+		period:      period,
+		timeout:     timeout,
+	}
+}
+
+// Run checks files system once per period until ctx is done, first check is done immediately.
+func (c *StorageHealthChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.period)
+	defer ticker.Stop()
+
+	status := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if next := c.check(ctx); next != status {
+			status = next
+			logrus.WithField("status", status.String()).Info("storage health")
+			// Empty service is health of whole server.
+			c.health.SetServingStatus("", status)
+			c.health.SetServingStatus(files.FilesService_ServiceDesc.ServiceName, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *StorageHealthChecker) check(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if _, err := c.filesSystem.ListFilesInfo(ctx, ""); err != nil {
+		logrus.WithError(err).Warn("check storage health")
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
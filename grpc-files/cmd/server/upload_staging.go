@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrUploadNotFound      = errors.New("upload not found")
+	ErrInvalidUploadOffset = errors.New("invalid upload offset")
+	ErrUploadInProgress    = errors.New("upload is in progress in other stream")
+)
+
+type UploadSession struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        uint64    `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Checksums are expected digests of whole file, they are checked when upload is completed.
+	Checksums FileChecksums `json:"checksums"`
+	// IfMatch are preconditions of upload, they are checked when upload is completed.
+	IfMatch []string `json:"if_match,omitempty"`
+	// Owner is id of principal who started upload, only owner can resume it.
+	Owner string `json:"owner,omitempty"`
+
+	// Offset is count of bytes received and saved in staging, it is not stored in session file.
+	Offset uint64 `json:"-"`
+	// ActiveAt is time of last resume or received content, it is modification time of part file.
+	ActiveAt time.Time `json:"-"`
+}
+
+func (s *UploadSession) Completed() bool {
+	return s.Size == 0 || s.Offset >= s.Size
+}
+
+// OwnedBy checks that upload was started by principal of context.
+func (s *UploadSession) OwnedBy(ctx context.Context) bool {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return s.Owner == ""
+	}
+	return s.Owner == principal.ID
+}
+
+// UploadStaging keeps not completed uploads: session file with upload info and part file with received content.
+type UploadStaging struct {
+	dir string
+
+	mu     sync.Mutex
+	locked map[string]struct{}
+}
+
+func MustNewUploadStaging(dir string) *UploadStaging {
+	staging, err := NewUploadStaging(dir)
+	if err != nil {
+		panic(fmt.Errorf("fatal init upload staging: %w", err))
+	}
+	return staging
+}
+
+func NewUploadStaging(dir string) (*UploadStaging, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create staging dir: %w", err)
+	}
+
+	return &UploadStaging{
+		dir:    dir,
+		locked: make(map[string]struct{}),
+	}, nil
+}
+
+// Lock gives exclusive access to upload for one stream, content of upload is appended only under lock.
+func (s *UploadStaging) Lock(id string) (unlock func(), err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.locked[id]; ok {
+		return nil, ErrUploadInProgress
+	}
+	s.locked[id] = struct{}{}
+
+	return func() {
+		s.mu.Lock()
+		delete(s.locked, id)
+		s.mu.Unlock()
+	}, nil
+}
+
+func (s *UploadStaging) CreateSession(ctx context.Context, info UploadInfo) (*UploadSession, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("generate upload id: %w", err)
+	}
+
+	session := UploadSession{
+		ID:          hex.EncodeToString(id),
+		Name:        info.Name,
+		ContentType: info.ContentType,
+		Size:        info.Size,
+		CreatedAt:   time.Now().UTC(),
+		Checksums:   info.Checksums,
+		IfMatch:     info.IfMatch,
+	}
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		session.Owner = principal.ID
+	}
+
+	content, err := json.Marshal(session)
+	if err != nil {
+		return nil, fmt.Errorf("marshal session: %w", err)
+	}
+
+	if err = os.WriteFile(s.partPath(session.ID), nil, 0o644); err != nil {
+		return nil, fmt.Errorf("create part file: %w", err)
+	}
+
+	if err = os.WriteFile(s.sessionPath(session.ID), content, 0o644); err != nil {
+		os.Remove(s.partPath(session.ID))
+		return nil, fmt.Errorf("write session file: %w", err)
+	}
+
+	return &session, nil
+}
+
+func (s *UploadStaging) GetSession(_ context.Context, id string) (*UploadSession, error) {
+	if !isUploadID(id) {
+		return nil, ErrUploadNotFound
+	}
+
+	content, err := os.ReadFile(s.sessionPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read session file: %w", err)
+	}
+
+	var session UploadSession
+	if err = json.Unmarshal(content, &session); err != nil {
+		return nil, fmt.Errorf("unmarshal session file: %w", err)
+	}
+
+	info, err := os.Stat(s.partPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get part file info: %w", err)
+	}
+
+	session.Offset = uint64(info.Size())
+	session.ActiveAt = info.ModTime()
+
+	return &session, nil
+}
+
+// Append writes content to end of part file and moves offset of session.
+func (s *UploadStaging) Append(_ context.Context, session *UploadSession, content io.Reader) (err error) {
+	f, err := os.OpenFile(s.partPath(session.ID), os.O_WRONLY|os.O_APPEND, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrUploadNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("open part file: %w", err)
+	}
+	// Resumed upload is active even if no content is received yet, so it does not expire while client waits.
+	now := time.Now()
+	if err = os.Chtimes(s.partPath(session.ID), now, now); err != nil {
+		f.Close()
+		return fmt.Errorf("touch part file: %w", err)
+	}
+	session.ActiveAt = now
+
+	defer func() {
+		// Received content is kept even if stream is broken, so sync it for resume from actual offset.
+		if syncErr := f.Sync(); syncErr != nil && err == nil {
+			err = fmt.Errorf("sync part file: %w", syncErr)
+		}
+		f.Close()
+	}()
+
+	written, err := io.Copy(f, content)
+	session.Offset += uint64(written)
+	if err != nil {
+		return fmt.Errorf("copy content to part file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *UploadStaging) OpenContent(_ context.Context, session *UploadSession) (io.ReadCloser, error) {
+	f, err := os.Open(s.partPath(session.ID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open part file: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *UploadStaging) DeleteSession(_ context.Context, id string) error {
+	if err := os.Remove(s.sessionPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove session file: %w", err)
+	}
+
+	if err := os.Remove(s.partPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove part file: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes not completed uploads, which were not active since passed time,
+// so uploads, which are still resumed, are kept however long ago they were started.
+func (s *UploadStaging) DeleteExpired(ctx context.Context, before time.Time) (deleted int, err error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return 0, fmt.Errorf("read staging dir: %w", err)
+	}
+
+	for _, entry := range entries {
+		id := strings.TrimSuffix(entry.Name(), ".json")
+		if id == entry.Name() {
+			continue
+		}
+
+		// Upload is checked under lock, so it is not deleted while it is resumed.
+		unlock, err := s.Lock(id)
+		if errors.Is(err, ErrUploadInProgress) {
+			continue
+		}
+
+		expired, err := s.deleteExpired(ctx, id, before)
+		unlock()
+		if err != nil {
+			return deleted, fmt.Errorf("delete session %s: %w", id, err)
+		}
+		if expired {
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// deleteExpired deletes locked upload if it is expired.
+func (s *UploadStaging) deleteExpired(ctx context.Context, id string, before time.Time) (bool, error) {
+	session, err := s.GetSession(ctx, id)
+	if errors.Is(err, ErrUploadNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !session.ActiveAt.Before(before) {
+		return false, nil
+	}
+
+	return true, s.DeleteSession(ctx, id)
+}
+
+func (s *UploadStaging) sessionPath(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+func (s *UploadStaging) partPath(id string) string {
+	return filepath.Join(s.dir, id+".part")
+}
+
+// isUploadID protects staging dir from ids like "../name".
+func isUploadID(id string) bool {
+	if len(id) != 32 {
+		return false
+	}
+
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
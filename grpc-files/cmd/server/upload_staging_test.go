@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestUploadSession(t *testing.T, staging *UploadStaging, name string, activeAt time.Time) *UploadSession {
+	t.Helper()
+
+	session, err := staging.CreateSession(context.Background(), UploadInfo{Name: name, Size: 10})
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	if err = os.Chtimes(staging.partPath(session.ID), activeAt, activeAt); err != nil {
+		t.Fatalf("set activity time of session: %v", err)
+	}
+
+	return session
+}
+
+func TestUploadStaging_DeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	staging := MustNewUploadStaging(t.TempDir())
+
+	now := time.Now()
+	ttl := time.Hour
+
+	idle := newTestUploadSession(t, staging, "idle", now.Add(-2*ttl))
+	fresh := newTestUploadSession(t, staging, "fresh", now)
+	resumed := newTestUploadSession(t, staging, "resumed", now.Add(-2*ttl))
+	locked := newTestUploadSession(t, staging, "locked", now.Add(-2*ttl))
+
+	// Upload started long ago, but resumed recently is active.
+	if err := staging.Append(ctx, resumed, strings.NewReader("12345")); err != nil {
+		t.Fatalf("append content: %v", err)
+	}
+
+	unlock, err := staging.Lock(locked.ID)
+	if err != nil {
+		t.Fatalf("lock session: %v", err)
+	}
+	defer unlock()
+
+	deleted, err := staging.DeleteExpired(ctx, now.Add(-ttl))
+	if err != nil {
+		t.Fatalf("delete expired sessions: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 deleted session, got %d", deleted)
+	}
+
+	if _, err = staging.GetSession(ctx, idle.ID); !errors.Is(err, ErrUploadNotFound) {
+		t.Fatalf("expected idle session is deleted, got %v", err)
+	}
+	for _, session := range []*UploadSession{fresh, resumed, locked} {
+		if _, err = staging.GetSession(ctx, session.ID); err != nil {
+			t.Fatalf("expected session %s is kept, got %v", session.Name, err)
+		}
+	}
+}
+
+func TestUploadStaging_AppendAndGetSession(t *testing.T) {
+	ctx := context.Background()
+	staging := MustNewUploadStaging(t.TempDir())
+
+	session := newTestUploadSession(t, staging, "file.txt", time.Now().Add(-time.Hour))
+
+	for _, part := range []string{"123", "4567"} {
+		if err := staging.Append(ctx, session, strings.NewReader(part)); err != nil {
+			t.Fatalf("append content: %v", err)
+		}
+	}
+
+	stored, err := staging.GetSession(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("get session: %v", err)
+	}
+	if stored.Name != "file.txt" || stored.Size != 10 || stored.Offset != 7 || session.Offset != 7 {
+		t.Fatalf("unexpected session %+v", stored)
+	}
+	if time.Since(stored.ActiveAt) > time.Minute {
+		t.Fatalf("activity time %s is not moved by append", stored.ActiveAt)
+	}
+
+	content, err := staging.OpenContent(ctx, stored)
+	if err != nil {
+		t.Fatalf("open content: %v", err)
+	}
+	defer content.Close()
+	if b, _ := io.ReadAll(content); string(b) != "1234567" {
+		t.Fatalf("unexpected staged content %q", b)
+	}
+}
+
+func TestUploadStaging_GetSessionInvalidID(t *testing.T) {
+	staging := MustNewUploadStaging(t.TempDir())
+
+	for _, id := range []string{"", "../session", strings.Repeat("z", 32), strings.Repeat("0", 32)} {
+		if _, err := staging.GetSession(context.Background(), id); !errors.Is(err, ErrUploadNotFound) {
+			t.Fatalf("expected ErrUploadNotFound of id %q, got %v", id, err)
+		}
+	}
+}
+
+func TestUploadStaging_Lock(t *testing.T) {
+	staging := MustNewUploadStaging(t.TempDir())
+
+	unlock, err := staging.Lock("id")
+	if err != nil {
+		t.Fatalf("lock: %v", err)
+	}
+	if _, err = staging.Lock("id"); !errors.Is(err, ErrUploadInProgress) {
+		t.Fatalf("expected ErrUploadInProgress, got %v", err)
+	}
+
+	unlock()
+	unlock, err = staging.Lock("id")
+	if err != nil {
+		t.Fatalf("lock after unlock: %v", err)
+	}
+	unlock()
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/EmptyShadow/go-examples/grpc-files/pb/files/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validator is implemented by messages generated with protoc-gen-validate.
+type validator interface {
+	Validate() error
+}
+
+func ValidationUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validateMessage(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func ValidationStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingServerStream{ServerStream: ss})
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(m)
+}
+
+func validateMessage(m interface{}) error {
+	v, ok := m.(validator)
+	if !ok {
+		return nil
+	}
+
+	if err := v.Validate(); err != nil {
+		return validationStatusError(err)
+	}
+
+	return nil
+}
+
+// fieldValidationError is error of field of message generated with protoc-gen-validate,
+// cause of error of embedded message is error of its field.
+type fieldValidationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// validationStatusError returns status error with BadRequest of invalid field, path of field is in proto names,
+// like "file_info.name", as in details of errors of files service.
+func validationStatusError(err error) error {
+	var (
+		path   []string
+		reason string
+	)
+	for cause := err; cause != nil; {
+		fieldErr, ok := cause.(fieldValidationError)
+		if !ok {
+			break
+		}
+		path = append(path, protoFieldName(fieldErr.Field()))
+		reason = fieldErr.Reason()
+		cause = fieldErr.Cause()
+	}
+
+	st := status.New(codes.InvalidArgument, err.Error())
+	if len(path) == 0 {
+		return st.Err()
+	}
+
+	withDetails, detailsErr := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "INVALID_ARGUMENT", Domain: files.FilesService_ServiceDesc.ServiceName},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: strings.Join(path, "."), Description: reason}},
+		},
+	)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// protoFieldName converts Go name of field to proto name, for example FileInfo to file_info and Crc32C to crc32c.
+func protoFieldName(goName string) string {
+	var b strings.Builder
+	for i, r := range goName {
+		if unicode.IsUpper(r) && i > 0 && unicode.IsLower(rune(goName[i-1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,333 @@
+// Package config loads config of binary from YAML file, environment variables and flags.
+// Flag overrides environment variable, which overrides file, which overrides default value of config.
+//
+// Fields of config struct are described by tags:
+//
+//	yaml:"name"    key in file
+//	env:"NAME"     environment variable, empty variable is not set
+//	flag:"name"    command line flag
+//	usage:"text"   help of flag
+//	secret:"true"  value is hidden when config is printed
+//
+// Supported types of fields are strings, bools, numbers, time.Duration, string slices, which are
+// comma separated in variables and flags, and nested structs.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const hiddenSecret = "<hidden>"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Validator is implemented by config, which checks itself after it is loaded.
+type Validator interface {
+	Validate() error
+}
+
+// Load fills cfg, which is pointer to struct with default values, from file of -config flag or of fileEnv
+// variable, from environment and from flags of args. Config is validated if it implements Validator.
+// printConfig is true if -print-config flag is set, caller is expected to print config and exit.
+func Load(cfg interface{}, fileEnv string, args []string) (printConfig bool, err error) {
+	fields, err := collectFields(reflect.ValueOf(cfg).Elem())
+	if err != nil {
+		return false, err
+	}
+
+	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	file := flags.String("config", os.Getenv(fileEnv), fmt.Sprintf("YAML config file (env %s)", fileEnv))
+	printConfigFlag := flags.Bool("print-config", false, "print config with hidden secrets and exit")
+
+	flagValues := make([]*flagValue, 0, len(fields))
+	for _, f := range fields {
+		if f.flag == "" {
+			continue
+		}
+
+		usage := f.usage
+		if f.env != "" {
+			usage = fmt.Sprintf("%s (env %s)", usage, f.env)
+		}
+
+		v := &flagValue{field: f}
+		// Zero default is not shown in usage.
+		if !f.value.IsZero() {
+			v.defaultValue = formatValue(f.value)
+		}
+		flags.Var(v, f.flag, usage)
+		flagValues = append(flagValues, v)
+	}
+
+	if err = flags.Parse(args); err != nil {
+		return false, err
+	}
+
+	if *file != "" {
+		if err = loadFile(*file, cfg); err != nil {
+			return false, err
+		}
+	}
+
+	for _, f := range fields {
+		v := os.Getenv(f.env)
+		if f.env == "" || v == "" {
+			continue
+		}
+		if err = setValue(f.value, v); err != nil {
+			return false, fmt.Errorf("parse %s: %w", f.env, err)
+		}
+	}
+
+	for _, v := range flagValues {
+		if !v.set {
+			continue
+		}
+		if err = setValue(v.field.value, v.raw); err != nil {
+			return false, fmt.Errorf("parse flag %s: %w", v.field.flag, err)
+		}
+	}
+
+	if validator, ok := cfg.(Validator); ok {
+		if err = validator.Validate(); err != nil {
+			return false, fmt.Errorf("invalid config: %w", err)
+		}
+	}
+
+	return *printConfigFlag, nil
+}
+
+// Print writes cfg as YAML, which can be used as config file, secrets are hidden.
+func Print(w io.Writer, cfg interface{}) error {
+	node, err := encodeStruct(reflect.ValueOf(cfg).Elem())
+	if err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(node); err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+func loadFile(path string, cfg interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open config file: %w", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	// Unknown keys are typos in most cases, so they are not ignored.
+	decoder.KnownFields(true)
+
+	if err = decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("decode config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+type field struct {
+	value reflect.Value
+	env   string
+	flag  string
+	usage string
+}
+
+func collectFields(v reflect.Value) ([]field, error) {
+	fields := make([]field, 0)
+
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		if structField.Type.Kind() == reflect.Struct {
+			nested, err := collectFields(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, nested...)
+			continue
+		}
+
+		if !isSupported(structField.Type) {
+			return nil, fmt.Errorf("unsupported type %s of config field %s", structField.Type, structField.Name)
+		}
+
+		fields = append(fields, field{
+			value: v.Field(i),
+			env:   structField.Tag.Get("env"),
+			flag:  structField.Tag.Get("flag"),
+			usage: structField.Tag.Get("usage"),
+		})
+	}
+
+	return fields, nil
+}
+
+func isSupported(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.String
+	default:
+		return false
+	}
+}
+
+func setValue(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		items := make([]string, 0)
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	if v.Kind() == reflect.Slice {
+		return strings.Join(v.Interface().([]string), ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// flagValue keeps value of flag until file and environment are loaded, so flag overrides them.
+type flagValue struct {
+	field        field
+	defaultValue string
+	raw          string
+	set          bool
+}
+
+func (v *flagValue) String() string {
+	if v == nil {
+		return ""
+	}
+	if v.set {
+		return v.raw
+	}
+	return v.defaultValue
+}
+
+func (v *flagValue) Set(s string) error {
+	// Value is checked now, so invalid flag is reported with usage.
+	if err := setValue(reflect.New(v.field.value.Type()).Elem(), s); err != nil {
+		return err
+	}
+
+	v.raw, v.set = s, true
+
+	return nil
+}
+
+func (v *flagValue) IsBoolFlag() bool {
+	return v.field.value.Kind() == reflect.Bool
+}
+
+func encodeStruct(v reflect.Value) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		key, _, _ := strings.Cut(structField.Tag.Get("yaml"), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(structField.Name)
+		}
+
+		var (
+			valueNode *yaml.Node
+			err       error
+		)
+		switch {
+		case structField.Type.Kind() == reflect.Struct:
+			valueNode, err = encodeStruct(v.Field(i))
+		case structField.Tag.Get("secret") == "true" && !v.Field(i).IsZero():
+			valueNode = &yaml.Node{Kind: yaml.ScalarNode, Value: hiddenSecret}
+		case structField.Type == durationType:
+			valueNode = &yaml.Node{Kind: yaml.ScalarNode, Value: formatValue(v.Field(i))}
+		default:
+			valueNode = &yaml.Node{}
+			err = valueNode.Encode(v.Field(i).Interface())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("encode config field %s: %w", structField.Name, err)
+		}
+
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, valueNode)
+	}
+
+	return node, nil
+}
@@ -0,0 +1,109 @@
+// Package logging sets up structured logging of binary and keeps logger of request with its id in context.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// RequestIDHeader is HTTP header and grpc metadata with id of request.
+const RequestIDHeader = "x-request-id"
+
+// Formats of log.
+const (
+	JSONFormat = "json"
+	TextFormat = "text"
+)
+
+// requestIDPattern limits ids of requests sent by clients, so they can not break log lines.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type Config struct {
+	Level  string `yaml:"level" env:"LOG_LEVEL" flag:"log-level" usage:"level of log: debug, info, warn or error"`
+	Format string `yaml:"format" env:"LOG_FORMAT" usage:"format of log: json or text"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Level:  logrus.InfoLevel.String(),
+		Format: JSONFormat,
+	}
+}
+
+func (c *Config) Validate() error {
+	if _, err := logrus.ParseLevel(c.Level); err != nil {
+		return err
+	}
+
+	switch c.Format {
+	case JSONFormat, TextFormat:
+	default:
+		return fmt.Errorf("unknown format of log %q", c.Format)
+	}
+
+	return nil
+}
+
+// Setup configures standard logger of logrus, messages of standard log package are written to it as warnings.
+func Setup(cfg Config) error {
+	level, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return fmt.Errorf("parse level of log: %w", err)
+	}
+	logrus.SetLevel(level)
+
+	switch cfg.Format {
+	case JSONFormat:
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	case TextFormat:
+		logrus.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	default:
+		return fmt.Errorf("unknown format of log %q", cfg.Format)
+	}
+
+	log.SetFlags(0)
+	log.SetOutput(logrus.StandardLogger().WriterLevel(logrus.WarnLevel))
+
+	return nil
+}
+
+type loggerKey struct{}
+
+// ContextWithLogger returns context with logger of request.
+func ContextWithLogger(ctx context.Context, logger *logrus.Entry) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns logger of request or standard logger if context has no logger.
+func FromContext(ctx context.Context) *logrus.Entry {
+	if logger, ok := ctx.Value(loggerKey{}).(*logrus.Entry); ok {
+		return logger
+	}
+	return logrus.NewEntry(logrus.StandardLogger())
+}
+
+// RequestID returns id of request sent by client if it is valid, else new id.
+func RequestID(fromClient string) string {
+	if requestIDPattern.MatchString(fromClient) {
+		return fromClient
+	}
+	return uuid.NewString()
+}
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns context with id of request.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns id of request or empty string if context has no id.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
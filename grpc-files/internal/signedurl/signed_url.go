@@ -0,0 +1,97 @@
+// Package signedurl signs and verifies tokens of URLs, which give access to one file without credentials.
+package signedurl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QueryParam is name of query parameter of URL with token.
+const QueryParam = "signature"
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature of url")
+	ErrExpired          = errors.New("url is expired")
+)
+
+// Claims are what URL allows: HTTP method, name of file and principal on behalf of whom file is accessed.
+type Claims struct {
+	Method    string `json:"m"`
+	Name      string `json:"n"`
+	Principal string `json:"p"`
+	ExpiresAt int64  `json:"e"`
+}
+
+type Signer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+func NewSigner(secret []byte) (*Signer, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("empty secret of signed urls")
+	}
+
+	return &Signer{secret: secret, now: time.Now}, nil
+}
+
+// Sign returns token "<payload>.<signature>" encoded by base64url.
+func (s *Signer) Sign(claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal claims: %w", err)
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(s.mac(encodedPayload)), nil
+}
+
+// Verify checks signature and expiration of token.
+func (s *Signer) Verify(token string) (Claims, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.mac(encodedPayload)) {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	var claims Claims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	if s.now().Unix() >= claims.ExpiresAt {
+		return Claims{}, ErrExpired
+	}
+
+	return claims, nil
+}
+
+// Allows checks that request with HTTP method to file with name is allowed by claims, HEAD is allowed by GET.
+func (c Claims) Allows(method, name string) bool {
+	if method == "HEAD" {
+		method = "GET"
+	}
+	return c.Method == method && c.Name == name
+}
+
+func (s *Signer) mac(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,125 @@
+package signedurl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	now := time.Unix(1000, 0)
+	claims := Claims{Method: "GET", Name: "folder/file.txt", Principal: "alice", ExpiresAt: 1001}
+
+	signer, err := NewSigner([]byte("secret"))
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	signer.now = func() time.Time { return now }
+
+	otherSigner, err := NewSigner([]byte("other"))
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+
+	token, err := signer.Sign(claims)
+	if err != nil {
+		t.Fatalf("sign claims: %v", err)
+	}
+	expiredToken, err := signer.Sign(Claims{Method: "GET", Name: "folder/file.txt", ExpiresAt: 1000})
+	if err != nil {
+		t.Fatalf("sign claims: %v", err)
+	}
+	otherToken, err := otherSigner.Sign(claims)
+	if err != nil {
+		t.Fatalf("sign claims: %v", err)
+	}
+
+	payload, _, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{name: "valid", token: token},
+		{name: "expired", token: expiredToken, err: ErrExpired},
+		{name: "other secret", token: otherToken, err: ErrInvalidSignature},
+		{name: "no signature", token: payload, err: ErrInvalidSignature},
+		{name: "empty signature", token: payload + ".", err: ErrInvalidSignature},
+		{name: "signature is not base64", token: payload + ".!", err: ErrInvalidSignature},
+		{name: "empty", token: "", err: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verified, err := signer.Verify(tt.token)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verify token: %v", err)
+			}
+			if verified != claims {
+				t.Fatalf("expected claims %+v, got %+v", claims, verified)
+			}
+		})
+	}
+}
+
+func TestSigner_VerifyTamperedPayload(t *testing.T) {
+	signer, err := NewSigner([]byte("secret"))
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+
+	token, err := signer.Sign(Claims{Method: "GET", Name: "a", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("sign claims: %v", err)
+	}
+	tampered, err := signer.Sign(Claims{Method: "PUT", Name: "a", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("sign claims: %v", err)
+	}
+
+	// Payload of one token with signature of other one is rejected.
+	payload, _, _ := strings.Cut(tampered, ".")
+	_, signature, _ := strings.Cut(token, ".")
+	if _, err = signer.Verify(payload + "." + signature); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+func TestNewSigner_EmptySecret(t *testing.T) {
+	if _, err := NewSigner(nil); err == nil {
+		t.Fatal("expected error of empty secret")
+	}
+}
+
+func TestClaims_Allows(t *testing.T) {
+	claims := Claims{Method: "GET", Name: "folder/file.txt"}
+
+	tests := []struct {
+		method string
+		name   string
+		allows bool
+	}{
+		{method: "GET", name: "folder/file.txt", allows: true},
+		{method: "HEAD", name: "folder/file.txt", allows: true},
+		{method: "PUT", name: "folder/file.txt"},
+		{method: "DELETE", name: "folder/file.txt"},
+		{method: "GET", name: "folder/other.txt"},
+		{method: "GET", name: "folder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.name, func(t *testing.T) {
+			if allows := claims.Allows(tt.method, tt.name); allows != tt.allows {
+				t.Fatalf("expected %t, got %t", tt.allows, allows)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Package tlsconfig builds TLS configs of server and client from PEM files, which are reloaded
+// when files are changed, so certificates are rotated without restart.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// checkInterval limits how often files are checked for changes, they are checked on handshakes.
+const checkInterval = time.Second
+
+// KeyPair is certificate with private key, which is reloaded when one of files is changed.
+// Previous certificate is used if changed files can not be loaded, for example while they are written,
+// and error of reload is logged.
+type KeyPair struct {
+	certFile string
+	keyFile  string
+	logger   logrus.FieldLogger
+
+	mu    sync.Mutex
+	cert  *tls.Certificate
+	files watchedFiles
+}
+
+func LoadKeyPair(certFile, keyFile string, logger logrus.FieldLogger) (*KeyPair, error) {
+	p := &KeyPair{
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger,
+		files:    watchedFiles{paths: []string{certFile, keyFile}},
+	}
+
+	p.files.changed()
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *KeyPair) Certificate() *tls.Certificate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.files.changed() {
+		if err := p.load(); err != nil {
+			p.files.reset()
+			p.logger.WithError(err).WithField("file", p.certFile).Warn("reload tls key pair")
+		}
+	}
+
+	return p.cert
+}
+
+func (p *KeyPair) load() error {
+	cert, err := tls.LoadX509KeyPair(p.certFile, p.keyFile)
+	if err != nil {
+		return fmt.Errorf("load key pair %s: %w", p.certFile, err)
+	}
+
+	p.cert = &cert
+
+	return nil
+}
+
+// CertPool is pool of CA certificates from PEM file, which is reloaded when file is changed.
+type CertPool struct {
+	file   string
+	logger logrus.FieldLogger
+
+	mu    sync.Mutex
+	pool  *x509.CertPool
+	files watchedFiles
+}
+
+func LoadCertPool(file string, logger logrus.FieldLogger) (*CertPool, error) {
+	p := &CertPool{
+		file:   file,
+		logger: logger,
+		files:  watchedFiles{paths: []string{file}},
+	}
+
+	p.files.changed()
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *CertPool) Pool() *x509.CertPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.files.changed() {
+		if err := p.load(); err != nil {
+			p.files.reset()
+			p.logger.WithError(err).WithField("file", p.file).Warn("reload tls cert pool")
+		}
+	}
+
+	return p.pool
+}
+
+func (p *CertPool) load() error {
+	content, err := os.ReadFile(p.file)
+	if err != nil {
+		return fmt.Errorf("read ca file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(content) {
+		return fmt.Errorf("no certificates in ca file %s", p.file)
+	}
+
+	p.pool = pool
+
+	return nil
+}
+
+// NewServerConfig returns config of server with certificate of key pair. Clients are verified by
+// clientCAs if it is not nil, clientAuth tells whether client certificate is required.
+func NewServerConfig(keyPair *KeyPair, clientCAs *CertPool, clientAuth tls.ClientAuthType) (*tls.Config, error) {
+	if keyPair == nil {
+		return nil, errors.New("key pair of server is required")
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Protocols are set here, since config of connection is returned by GetConfigForClient,
+		// so protocols added by users of config, like grpc and http servers, are not applied.
+		NextProtos: []string{"h2", "http/1.1"},
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return keyPair.Certificate(), nil
+		},
+	}
+
+	if clientCAs == nil {
+		return config, nil
+	}
+
+	base := config.Clone()
+	base.ClientAuth = clientAuth
+	// Pool of client CAs is got on every handshake, so changed CA file is applied to new connections.
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		connConfig := base.Clone()
+		connConfig.ClientCAs = clientCAs.Pool()
+		return connConfig, nil
+	}
+
+	return config, nil
+}
+
+// NewClientConfig returns config of client, which verifies server by rootCAs or by system pool if it is nil.
+// Certificate of key pair is presented to server if key pair is not nil.
+func NewClientConfig(rootCAs *x509.CertPool, keyPair *KeyPair, serverName string) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    rootCAs,
+		ServerName: serverName,
+	}
+
+	if keyPair != nil {
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return keyPair.Certificate(), nil
+		}
+	}
+
+	return config
+}
+
+// LoadServerConfig loads config of server from PEM files, clients are verified if clientCAFile is not empty.
+// Clients without certificate are accepted unless requireClientCert is set. Errors of reloads are logged by logger.
+func LoadServerConfig(certFile, keyFile, clientCAFile string, requireClientCert bool, logger logrus.FieldLogger) (*tls.Config, error) {
+	keyPair, err := LoadKeyPair(certFile, keyFile, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientCAs *CertPool
+	if clientCAFile != "" {
+		if clientCAs, err = LoadCertPool(clientCAFile, logger); err != nil {
+			return nil, fmt.Errorf("load client cas: %w", err)
+		}
+	}
+
+	clientAuth := tls.VerifyClientCertIfGiven
+	if requireClientCert {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return NewServerConfig(keyPair, clientCAs, clientAuth)
+}
+
+// LoadClientConfig loads config of client from PEM files, empty caFile is system pool and empty certFile
+// is client without certificate. CAs are loaded once, certificate of client is reloaded.
+func LoadClientConfig(caFile, certFile, keyFile, serverName string, logger logrus.FieldLogger) (*tls.Config, error) {
+	var rootCAs *x509.CertPool
+	if caFile != "" {
+		pool, err := LoadCertPool(caFile, logger)
+		if err != nil {
+			return nil, fmt.Errorf("load root cas: %w", err)
+		}
+		rootCAs = pool.Pool()
+	}
+
+	var keyPair *KeyPair
+	if certFile != "" {
+		var err error
+		if keyPair, err = LoadKeyPair(certFile, keyFile, logger); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewClientConfig(rootCAs, keyPair, serverName), nil
+}
+
+// watchedFiles detects changes of files by modification time and size.
+type watchedFiles struct {
+	paths     []string
+	stamps    []fileStamp
+	checkedAt time.Time
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// changed returns true if files are changed since previous call, files are checked once per checkInterval.
+func (w *watchedFiles) changed() bool {
+	now := time.Now()
+	if !w.checkedAt.IsZero() && now.Sub(w.checkedAt) < checkInterval {
+		return false
+	}
+	w.checkedAt = now
+
+	stamps := make([]fileStamp, len(w.paths))
+	for i, path := range w.paths {
+		// Not available file is not changed, so old content is kept while file is replaced.
+		info, err := os.Stat(path)
+		if err != nil {
+			return false
+		}
+		stamps[i] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	changed := len(w.stamps) != len(stamps)
+	for i := 0; !changed && i < len(stamps); i++ {
+		changed = !stamps[i].modTime.Equal(w.stamps[i].modTime) || stamps[i].size != w.stamps[i].size
+	}
+	w.stamps = stamps
+
+	return changed
+}
+
+// reset makes files changed on next check, so failed load is retried.
+func (w *watchedFiles) reset() {
+	w.stamps = nil
+}
@@ -0,0 +1,343 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// testCA issues certificates of servers and clients in tests.
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	serial  int64
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key of ca: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate of ca: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate of ca: %v", err)
+	}
+
+	return &testCA{cert: cert, key: key, certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), serial: 1}
+}
+
+// issue returns PEM certificate and key of server "localhost" or of client.
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key of %s: %v", name, err)
+	}
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create certificate of %s: %v", name, err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key of %s: %v", name, err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeTestFile(t *testing.T, path string, content []byte) string {
+	t.Helper()
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	return path
+}
+
+func newTestLogger() logrus.FieldLogger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+// handshake connects client and server by loopback and returns certificate of server seen by client and
+// error of server handshake, or error of client handshake if server accepted client.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (*x509.Certificate, error) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+
+	clientConn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer clientConn.Close()
+	serverConn, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	defer serverConn.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		server := tls.Server(serverConn, serverConfig)
+		err := server.Handshake()
+		if err == nil {
+			// Client of TLS 1.3 learns that its certificate is accepted by reading from server.
+			_, err = server.Write([]byte{1})
+		}
+		serverErr <- err
+	}()
+
+	client := tls.Client(clientConn, clientConfig)
+	clientErr := client.Handshake()
+	if clientErr == nil {
+		_, clientErr = client.Read(make([]byte, 1))
+	}
+	// Server waiting for rejected client is unblocked.
+	clientConn.Close()
+
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+	if clientErr != nil {
+		return nil, clientErr
+	}
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestLoadServerConfig_ClientCert(t *testing.T) {
+	dir := t.TempDir()
+	logger := newTestLogger()
+
+	ca := newTestCA(t, "ca")
+	otherCA := newTestCA(t, "other ca")
+	caFile := writeTestFile(t, filepath.Join(dir, "ca.pem"), ca.certPEM)
+
+	serverCert, serverKey := ca.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	serverCertFile := writeTestFile(t, filepath.Join(dir, "server.pem"), serverCert)
+	serverKeyFile := writeTestFile(t, filepath.Join(dir, "server-key.pem"), serverKey)
+
+	clientCert, clientKey := ca.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	clientCertFile := writeTestFile(t, filepath.Join(dir, "client.pem"), clientCert)
+	clientKeyFile := writeTestFile(t, filepath.Join(dir, "client-key.pem"), clientKey)
+
+	otherCert, otherKey := otherCA.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	otherCertFile := writeTestFile(t, filepath.Join(dir, "other.pem"), otherCert)
+	otherKeyFile := writeTestFile(t, filepath.Join(dir, "other-key.pem"), otherKey)
+
+	tests := []struct {
+		name              string
+		clientCAFile      string
+		requireClientCert bool
+		certFile          string
+		keyFile           string
+		rejected          bool
+	}{
+		{name: "required client cert", clientCAFile: caFile, requireClientCert: true, certFile: clientCertFile, keyFile: clientKeyFile},
+		{name: "missing required client cert", clientCAFile: caFile, requireClientCert: true, rejected: true},
+		{name: "client cert of other ca", clientCAFile: caFile, requireClientCert: true, certFile: otherCertFile, keyFile: otherKeyFile, rejected: true},
+		{name: "optional client cert", clientCAFile: caFile, certFile: clientCertFile, keyFile: clientKeyFile},
+		{name: "missing optional client cert", clientCAFile: caFile},
+		{name: "optional client cert of other ca", clientCAFile: caFile, certFile: otherCertFile, keyFile: otherKeyFile, rejected: true},
+		{name: "clients are not verified", certFile: otherCertFile, keyFile: otherKeyFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverConfig, err := LoadServerConfig(serverCertFile, serverKeyFile, tt.clientCAFile, tt.requireClientCert, logger)
+			if err != nil {
+				t.Fatalf("load server config: %v", err)
+			}
+			clientConfig, err := LoadClientConfig(caFile, tt.certFile, tt.keyFile, "localhost", logger)
+			if err != nil {
+				t.Fatalf("load client config: %v", err)
+			}
+
+			_, err = handshake(t, serverConfig, clientConfig)
+			if tt.rejected && err == nil {
+				t.Fatalf("expected rejected client")
+			}
+			if !tt.rejected && err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadClientConfig_ServerVerification(t *testing.T) {
+	dir := t.TempDir()
+	logger := newTestLogger()
+
+	ca := newTestCA(t, "ca")
+	otherCA := newTestCA(t, "other ca")
+	serverCert, serverKey := ca.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	serverConfig, err := LoadServerConfig(
+		writeTestFile(t, filepath.Join(dir, "server.pem"), serverCert),
+		writeTestFile(t, filepath.Join(dir, "server-key.pem"), serverKey),
+		"", false, logger,
+	)
+	if err != nil {
+		t.Fatalf("load server config: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		caPEM      []byte
+		serverName string
+		rejected   bool
+	}{
+		{name: "server of ca", caPEM: ca.certPEM, serverName: "localhost"},
+		{name: "server of other ca", caPEM: otherCA.certPEM, serverName: "localhost", rejected: true},
+		{name: "other server name", caPEM: ca.certPEM, serverName: "example.com", rejected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientConfig, err := LoadClientConfig(writeTestFile(t, filepath.Join(dir, "ca.pem"), tt.caPEM), "", "", tt.serverName, logger)
+			if err != nil {
+				t.Fatalf("load client config: %v", err)
+			}
+
+			_, err = handshake(t, serverConfig, clientConfig)
+			if tt.rejected && err == nil {
+				t.Fatalf("expected rejected server")
+			}
+			if !tt.rejected && err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadServerConfig_Reload(t *testing.T) {
+	dir := t.TempDir()
+	logger := newTestLogger()
+
+	ca := newTestCA(t, "ca")
+	newCA := newTestCA(t, "new ca")
+	caFile := writeTestFile(t, filepath.Join(dir, "both-ca.pem"), append(append([]byte{}, ca.certPEM...), newCA.certPEM...))
+
+	serverCert, serverKey := ca.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	serverCertFile := writeTestFile(t, filepath.Join(dir, "server.pem"), serverCert)
+	serverKeyFile := writeTestFile(t, filepath.Join(dir, "server-key.pem"), serverKey)
+	clientCAFile := writeTestFile(t, filepath.Join(dir, "client-ca.pem"), ca.certPEM)
+
+	keyPair, err := LoadKeyPair(serverCertFile, serverKeyFile, logger)
+	if err != nil {
+		t.Fatalf("load key pair: %v", err)
+	}
+	clientCAs, err := LoadCertPool(clientCAFile, logger)
+	if err != nil {
+		t.Fatalf("load cert pool: %v", err)
+	}
+	serverConfig, err := NewServerConfig(keyPair, clientCAs, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		t.Fatalf("new server config: %v", err)
+	}
+
+	// Rewritten files get later modification time, so change is seen on file systems with coarse time.
+	modTime := time.Now()
+	rewrite := func(path string, content []byte) {
+		writeTestFile(t, path, content)
+		modTime = modTime.Add(time.Second)
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatalf("change time of %s: %v", path, err)
+		}
+	}
+
+	// Files are checked once per checkInterval, time of check is reset to see changes at once.
+	expireChecks := func() {
+		keyPair.mu.Lock()
+		keyPair.files.checkedAt = time.Time{}
+		keyPair.mu.Unlock()
+		clientCAs.mu.Lock()
+		clientCAs.files.checkedAt = time.Time{}
+		clientCAs.mu.Unlock()
+	}
+
+	newClientCert, newClientKey := newCA.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	clientConfig, err := LoadClientConfig(caFile,
+		writeTestFile(t, filepath.Join(dir, "client.pem"), newClientCert),
+		writeTestFile(t, filepath.Join(dir, "client-key.pem"), newClientKey),
+		"localhost", logger)
+	if err != nil {
+		t.Fatalf("load client config: %v", err)
+	}
+
+	if _, err = handshake(t, serverConfig, clientConfig); err == nil {
+		t.Fatalf("expected rejected client of new ca before rotation")
+	}
+
+	// Certificate of server and CA of clients are rotated.
+	rotatedCert, rotatedKey := newCA.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	rewrite(serverCertFile, rotatedCert)
+	rewrite(serverKeyFile, rotatedKey)
+	rewrite(clientCAFile, newCA.certPEM)
+	expireChecks()
+
+	peer, err := handshake(t, serverConfig, clientConfig)
+	if err != nil {
+		t.Fatalf("handshake after rotation: %v", err)
+	}
+	if peer.Issuer.CommonName != "new ca" {
+		t.Fatalf("expected rotated certificate of server, got issued by %s", peer.Issuer.CommonName)
+	}
+
+	// Broken files are skipped, previous certificate and CAs are used.
+	rewrite(serverCertFile, []byte("broken"))
+	rewrite(clientCAFile, []byte("broken"))
+	expireChecks()
+
+	peer, err = handshake(t, serverConfig, clientConfig)
+	if err != nil {
+		t.Fatalf("handshake with broken files: %v", err)
+	}
+	if peer.Issuer.CommonName != "new ca" {
+		t.Fatalf("expected previous certificate of server, got issued by %s", peer.Issuer.CommonName)
+	}
+}
@@ -0,0 +1,4361 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: example/files/v1/files_service.proto
+
+package files
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListFilesHeaderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFilesHeaderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFilesHeaderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFilesHeaderRequestMultiError, or nil if none found.
+func (m *ListFilesHeaderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFilesHeaderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetParent() != "" {
+
+		if utf8.RuneCountInString(m.GetParent()) > 1024 {
+			err := ListFilesHeaderRequestValidationError{
+				field:  "Parent",
+				reason: "value length must be at most 1024 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ListFilesHeaderRequest_Parent_Pattern.MatchString(m.GetParent()) {
+			err := ListFilesHeaderRequestValidationError{
+				field:  "Parent",
+				reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for NamePrefix
+
+	// no validation rules for NamePattern
+
+	// no validation rules for OrderBy
+
+	if len(errors) > 0 {
+		return ListFilesHeaderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFilesHeaderRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFilesHeaderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFilesHeaderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFilesHeaderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFilesHeaderRequestMultiError) AllErrors() []error { return m }
+
+// ListFilesHeaderRequestValidationError is the validation error returned by
+// ListFilesHeaderRequest.Validate if the designated constraints aren't met.
+type ListFilesHeaderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFilesHeaderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFilesHeaderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFilesHeaderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFilesHeaderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFilesHeaderRequestValidationError) ErrorName() string {
+	return "ListFilesHeaderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFilesHeaderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFilesHeaderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFilesHeaderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFilesHeaderRequestValidationError{}
+
+var _ListFilesHeaderRequest_Parent_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on ListFilesHeaderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFilesHeaderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFilesHeaderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFilesHeaderResponseMultiError, or nil if none found.
+func (m *ListFilesHeaderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFilesHeaderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFilesHeaderResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFilesHeaderResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFilesHeaderResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return ListFilesHeaderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFilesHeaderResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFilesHeaderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFilesHeaderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFilesHeaderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFilesHeaderResponseMultiError) AllErrors() []error { return m }
+
+// ListFilesHeaderResponseValidationError is the validation error returned by
+// ListFilesHeaderResponse.Validate if the designated constraints aren't met.
+type ListFilesHeaderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFilesHeaderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFilesHeaderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFilesHeaderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFilesHeaderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFilesHeaderResponseValidationError) ErrorName() string {
+	return "ListFilesHeaderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFilesHeaderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFilesHeaderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFilesHeaderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFilesHeaderResponseValidationError{}
+
+// Validate checks the field values on UploadFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileRequestMultiError, or nil if none found.
+func (m *UploadFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Data.(type) {
+
+	case *UploadFileRequest_FileInfo:
+
+		if all {
+			switch v := interface{}(m.GetFileInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFileRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFileRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFileRequestValidationError{
+					field:  "FileInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadFileRequest_FileContentChunk:
+		// no validation rules for FileContentChunk
+
+	case *UploadFileRequest_FileChunk:
+
+		if all {
+			switch v := interface{}(m.GetFileChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFileRequestValidationError{
+						field:  "FileChunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFileRequestValidationError{
+						field:  "FileChunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFileRequestValidationError{
+					field:  "FileChunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UploadFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileRequestMultiError) AllErrors() []error { return m }
+
+// UploadFileRequestValidationError is the validation error returned by
+// UploadFileRequest.Validate if the designated constraints aren't met.
+type UploadFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileRequestValidationError) ErrorName() string {
+	return "UploadFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileRequestValidationError{}
+
+// Validate checks the field values on UploadFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileResponseMultiError, or nil if none found.
+func (m *UploadFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFileHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadFileResponseValidationError{
+				field:  "FileHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UploadId
+
+	// no validation rules for CommittedOffset
+
+	if len(errors) > 0 {
+		return UploadFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileResponseMultiError is an error wrapping multiple validation errors
+// returned by UploadFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UploadFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileResponseMultiError) AllErrors() []error { return m }
+
+// UploadFileResponseValidationError is the validation error returned by
+// UploadFileResponse.Validate if the designated constraints aren't met.
+type UploadFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileResponseValidationError) ErrorName() string {
+	return "UploadFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileResponseValidationError{}
+
+// Validate checks the field values on GetUploadStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadStatusRequestMultiError, or nil if none found.
+func (m *GetUploadStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UploadId
+
+	if len(errors) > 0 {
+		return GetUploadStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUploadStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUploadStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetUploadStatusRequestValidationError is the validation error returned by
+// GetUploadStatusRequest.Validate if the designated constraints aren't met.
+type GetUploadStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadStatusRequestValidationError) ErrorName() string {
+	return "GetUploadStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadStatusRequestValidationError{}
+
+// Validate checks the field values on GetUploadStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadStatusResponseMultiError, or nil if none found.
+func (m *GetUploadStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UploadId
+
+	// no validation rules for Name
+
+	// no validation rules for CommittedOffset
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return GetUploadStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUploadStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUploadStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetUploadStatusResponseValidationError is the validation error returned by
+// GetUploadStatusResponse.Validate if the designated constraints aren't met.
+type GetUploadStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadStatusResponseValidationError) ErrorName() string {
+	return "GetUploadStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadStatusResponseValidationError{}
+
+// Validate checks the field values on DownloadFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadFileRequestMultiError, or nil if none found.
+func (m *DownloadFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := DownloadFileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DownloadFileRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := DownloadFileRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Offset
+
+	// no validation rules for Length
+
+	if len(errors) > 0 {
+		return DownloadFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadFileRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadFileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadFileRequestMultiError) AllErrors() []error { return m }
+
+// DownloadFileRequestValidationError is the validation error returned by
+// DownloadFileRequest.Validate if the designated constraints aren't met.
+type DownloadFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadFileRequestValidationError) ErrorName() string {
+	return "DownloadFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadFileRequestValidationError{}
+
+var _DownloadFileRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on DownloadFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadFileResponseMultiError, or nil if none found.
+func (m *DownloadFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Data.(type) {
+
+	case *DownloadFileResponse_FileHeader:
+
+		if all {
+			switch v := interface{}(m.GetFileHeader()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadFileResponseValidationError{
+						field:  "FileHeader",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadFileResponseValidationError{
+						field:  "FileHeader",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadFileResponse_FileContentChunk:
+		// no validation rules for FileContentChunk
+
+	case *DownloadFileResponse_ContentDigest:
+
+		if all {
+			switch v := interface{}(m.GetContentDigest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadFileResponseValidationError{
+						field:  "ContentDigest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadFileResponseValidationError{
+						field:  "ContentDigest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetContentDigest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadFileResponseValidationError{
+					field:  "ContentDigest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DownloadFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadFileResponseMultiError is an error wrapping multiple validation
+// errors returned by DownloadFileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadFileResponseMultiError) AllErrors() []error { return m }
+
+// DownloadFileResponseValidationError is the validation error returned by
+// DownloadFileResponse.Validate if the designated constraints aren't met.
+type DownloadFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadFileResponseValidationError) ErrorName() string {
+	return "DownloadFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadFileResponseValidationError{}
+
+// Validate checks the field values on ContentDigest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContentDigest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentDigest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContentDigestMultiError, or
+// nil if none found.
+func (m *ContentDigest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentDigest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sha256
+
+	// no validation rules for Crc32C
+
+	if len(errors) > 0 {
+		return ContentDigestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentDigestMultiError is an error wrapping multiple validation errors
+// returned by ContentDigest.ValidateAll() if the designated constraints
+// aren't met.
+type ContentDigestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentDigestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentDigestMultiError) AllErrors() []error { return m }
+
+// ContentDigestValidationError is the validation error returned by
+// ContentDigest.Validate if the designated constraints aren't met.
+type ContentDigestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentDigestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentDigestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentDigestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentDigestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentDigestValidationError) ErrorName() string { return "ContentDigestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentDigestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentDigest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentDigestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentDigestValidationError{}
+
+// Validate checks the field values on GetFileHeaderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFileHeaderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileHeaderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileHeaderRequestMultiError, or nil if none found.
+func (m *GetFileHeaderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileHeaderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := GetFileHeaderRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetFileHeaderRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := GetFileHeaderRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFileHeaderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileHeaderRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFileHeaderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFileHeaderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileHeaderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileHeaderRequestMultiError) AllErrors() []error { return m }
+
+// GetFileHeaderRequestValidationError is the validation error returned by
+// GetFileHeaderRequest.Validate if the designated constraints aren't met.
+type GetFileHeaderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileHeaderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileHeaderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileHeaderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileHeaderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileHeaderRequestValidationError) ErrorName() string {
+	return "GetFileHeaderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileHeaderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileHeaderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileHeaderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileHeaderRequestValidationError{}
+
+var _GetFileHeaderRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on GetFileHeaderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFileHeaderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileHeaderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileHeaderResponseMultiError, or nil if none found.
+func (m *GetFileHeaderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileHeaderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFileHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFileHeaderResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFileHeaderResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFileHeaderResponseValidationError{
+				field:  "FileHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFileHeaderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileHeaderResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFileHeaderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFileHeaderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileHeaderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileHeaderResponseMultiError) AllErrors() []error { return m }
+
+// GetFileHeaderResponseValidationError is the validation error returned by
+// GetFileHeaderResponse.Validate if the designated constraints aren't met.
+type GetFileHeaderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileHeaderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileHeaderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileHeaderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileHeaderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileHeaderResponseValidationError) ErrorName() string {
+	return "GetFileHeaderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileHeaderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileHeaderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileHeaderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileHeaderResponseValidationError{}
+
+// Validate checks the field values on DeleteFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFileRequestMultiError, or nil if none found.
+func (m *DeleteFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := DeleteFileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteFileRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := DeleteFileRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFileRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFileRequestValidationError is the validation error returned by
+// DeleteFileRequest.Validate if the designated constraints aren't met.
+type DeleteFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFileRequestValidationError) ErrorName() string {
+	return "DeleteFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFileRequestValidationError{}
+
+var _DeleteFileRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on RenameFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameFileRequestMultiError, or nil if none found.
+func (m *RenameFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := RenameFileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RenameFileRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := RenameFileRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewName()) > 1024 {
+		err := RenameFileRequestValidationError{
+			field:  "NewName",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RenameFileRequest_NewName_Pattern.MatchString(m.GetNewName()) {
+		err := RenameFileRequestValidationError{
+			field:  "NewName",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameFileRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameFileRequestMultiError) AllErrors() []error { return m }
+
+// RenameFileRequestValidationError is the validation error returned by
+// RenameFileRequest.Validate if the designated constraints aren't met.
+type RenameFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameFileRequestValidationError) ErrorName() string {
+	return "RenameFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameFileRequestValidationError{}
+
+var _RenameFileRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+var _RenameFileRequest_NewName_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on RenameFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameFileResponseMultiError, or nil if none found.
+func (m *RenameFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFileHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenameFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenameFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenameFileResponseValidationError{
+				field:  "FileHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenameFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameFileResponseMultiError is an error wrapping multiple validation errors
+// returned by RenameFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RenameFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameFileResponseMultiError) AllErrors() []error { return m }
+
+// RenameFileResponseValidationError is the validation error returned by
+// RenameFileResponse.Validate if the designated constraints aren't met.
+type RenameFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameFileResponseValidationError) ErrorName() string {
+	return "RenameFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameFileResponseValidationError{}
+
+// Validate checks the field values on CopyFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CopyFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyFileRequestMultiError, or nil if none found.
+func (m *CopyFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := CopyFileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CopyFileRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CopyFileRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewName()) > 1024 {
+		err := CopyFileRequestValidationError{
+			field:  "NewName",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CopyFileRequest_NewName_Pattern.MatchString(m.GetNewName()) {
+		err := CopyFileRequestValidationError{
+			field:  "NewName",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CopyFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyFileRequestMultiError is an error wrapping multiple validation errors
+// returned by CopyFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CopyFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyFileRequestMultiError) AllErrors() []error { return m }
+
+// CopyFileRequestValidationError is the validation error returned by
+// CopyFileRequest.Validate if the designated constraints aren't met.
+type CopyFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyFileRequestValidationError) ErrorName() string { return "CopyFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CopyFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyFileRequestValidationError{}
+
+var _CopyFileRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+var _CopyFileRequest_NewName_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on CopyFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CopyFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyFileResponseMultiError, or nil if none found.
+func (m *CopyFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFileHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyFileResponseValidationError{
+					field:  "FileHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyFileResponseValidationError{
+				field:  "FileHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CopyFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyFileResponseMultiError is an error wrapping multiple validation errors
+// returned by CopyFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CopyFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyFileResponseMultiError) AllErrors() []error { return m }
+
+// CopyFileResponseValidationError is the validation error returned by
+// CopyFileResponse.Validate if the designated constraints aren't met.
+type CopyFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyFileResponseValidationError) ErrorName() string { return "CopyFileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CopyFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyFileResponseValidationError{}
+
+// Validate checks the field values on GetFileACLRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFileACLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileACLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileACLRequestMultiError, or nil if none found.
+func (m *GetFileACLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileACLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := GetFileACLRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetFileACLRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := GetFileACLRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFileACLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileACLRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileACLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileACLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileACLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileACLRequestMultiError) AllErrors() []error { return m }
+
+// GetFileACLRequestValidationError is the validation error returned by
+// GetFileACLRequest.Validate if the designated constraints aren't met.
+type GetFileACLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileACLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileACLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileACLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileACLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileACLRequestValidationError) ErrorName() string {
+	return "GetFileACLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileACLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileACLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileACLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileACLRequestValidationError{}
+
+var _GetFileACLRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on GetFileACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFileACLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileACLResponseMultiError, or nil if none found.
+func (m *GetFileACLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileACLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAcl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFileACLResponseValidationError{
+					field:  "Acl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFileACLResponseValidationError{
+					field:  "Acl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFileACLResponseValidationError{
+				field:  "Acl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFileACLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileACLResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFileACLResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileACLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileACLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileACLResponseMultiError) AllErrors() []error { return m }
+
+// GetFileACLResponseValidationError is the validation error returned by
+// GetFileACLResponse.Validate if the designated constraints aren't met.
+type GetFileACLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileACLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileACLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileACLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileACLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileACLResponseValidationError) ErrorName() string {
+	return "GetFileACLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileACLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileACLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileACLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileACLResponseValidationError{}
+
+// Validate checks the field values on SetFileACLRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetFileACLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFileACLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFileACLRequestMultiError, or nil if none found.
+func (m *SetFileACLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFileACLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := SetFileACLRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SetFileACLRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := SetFileACLRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAcl() == nil {
+		err := SetFileACLRequestValidationError{
+			field:  "Acl",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAcl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFileACLRequestValidationError{
+					field:  "Acl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFileACLRequestValidationError{
+					field:  "Acl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFileACLRequestValidationError{
+				field:  "Acl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetFileACLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFileACLRequestMultiError is an error wrapping multiple validation errors
+// returned by SetFileACLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetFileACLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFileACLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFileACLRequestMultiError) AllErrors() []error { return m }
+
+// SetFileACLRequestValidationError is the validation error returned by
+// SetFileACLRequest.Validate if the designated constraints aren't met.
+type SetFileACLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFileACLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFileACLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFileACLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFileACLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFileACLRequestValidationError) ErrorName() string {
+	return "SetFileACLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFileACLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFileACLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFileACLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFileACLRequestValidationError{}
+
+var _SetFileACLRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on SetFileACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFileACLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFileACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFileACLResponseMultiError, or nil if none found.
+func (m *SetFileACLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFileACLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAcl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFileACLResponseValidationError{
+					field:  "Acl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFileACLResponseValidationError{
+					field:  "Acl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFileACLResponseValidationError{
+				field:  "Acl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetFileACLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFileACLResponseMultiError is an error wrapping multiple validation errors
+// returned by SetFileACLResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetFileACLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFileACLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFileACLResponseMultiError) AllErrors() []error { return m }
+
+// SetFileACLResponseValidationError is the validation error returned by
+// SetFileACLResponse.Validate if the designated constraints aren't met.
+type SetFileACLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFileACLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFileACLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFileACLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFileACLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFileACLResponseValidationError) ErrorName() string {
+	return "SetFileACLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFileACLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFileACLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFileACLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFileACLResponseValidationError{}
+
+// Validate checks the field values on FileACL with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileACL) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileACL with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FileACLMultiError, or nil if none found.
+func (m *FileACL) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileACL) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Owner
+
+	if len(errors) > 0 {
+		return FileACLMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileACLMultiError is an error wrapping multiple validation errors returned
+// by FileACL.ValidateAll() if the designated constraints aren't met.
+type FileACLMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileACLMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileACLMultiError) AllErrors() []error { return m }
+
+// FileACLValidationError is the validation error returned by FileACL.Validate
+// if the designated constraints aren't met.
+type FileACLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileACLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileACLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileACLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileACLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileACLValidationError) ErrorName() string { return "FileACLValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileACLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileACL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileACLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileACLValidationError{}
+
+// Validate checks the field values on CreateSignedURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSignedURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSignedURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSignedURLRequestMultiError, or nil if none found.
+func (m *CreateSignedURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSignedURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := CreateSignedURLRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateSignedURLRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateSignedURLRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateSignedURLRequest_Method_InLookup[m.GetMethod()]; !ok {
+		err := CreateSignedURLRequestValidationError{
+			field:  "Method",
+			reason: "value must be in list [GET POST]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresIn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSignedURLRequestValidationError{
+					field:  "ExpiresIn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSignedURLRequestValidationError{
+					field:  "ExpiresIn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresIn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSignedURLRequestValidationError{
+				field:  "ExpiresIn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSignedURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSignedURLRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSignedURLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSignedURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSignedURLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSignedURLRequestMultiError) AllErrors() []error { return m }
+
+// CreateSignedURLRequestValidationError is the validation error returned by
+// CreateSignedURLRequest.Validate if the designated constraints aren't met.
+type CreateSignedURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSignedURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSignedURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSignedURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSignedURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSignedURLRequestValidationError) ErrorName() string {
+	return "CreateSignedURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSignedURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSignedURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSignedURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSignedURLRequestValidationError{}
+
+var _CreateSignedURLRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+var _CreateSignedURLRequest_Method_InLookup = map[string]struct{}{
+	"GET":  {},
+	"POST": {},
+}
+
+// Validate checks the field values on CreateSignedURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSignedURLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSignedURLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSignedURLResponseMultiError, or nil if none found.
+func (m *CreateSignedURLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSignedURLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSignedURLResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSignedURLResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSignedURLResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSignedURLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSignedURLResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSignedURLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSignedURLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSignedURLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSignedURLResponseMultiError) AllErrors() []error { return m }
+
+// CreateSignedURLResponseValidationError is the validation error returned by
+// CreateSignedURLResponse.Validate if the designated constraints aren't met.
+type CreateSignedURLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSignedURLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSignedURLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSignedURLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSignedURLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSignedURLResponseValidationError) ErrorName() string {
+	return "CreateSignedURLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSignedURLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSignedURLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSignedURLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSignedURLResponseValidationError{}
+
+// Validate checks the field values on GetUsageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageRequestMultiError, or nil if none found.
+func (m *GetUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUsageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetUsageRequestValidationError is the validation error returned by
+// GetUsageRequest.Validate if the designated constraints aren't met.
+type GetUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageRequestValidationError) ErrorName() string { return "GetUsageRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageRequestValidationError{}
+
+// Validate checks the field values on GetUsageResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageResponseMultiError, or nil if none found.
+func (m *GetUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsageResponseValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsageResponseValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsageResponseValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsageResponseValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxFileSize
+
+	if len(errors) > 0 {
+		return GetUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUsageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetUsageResponseValidationError is the validation error returned by
+// GetUsageResponse.Validate if the designated constraints aren't met.
+type GetUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageResponseValidationError) ErrorName() string { return "GetUsageResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageResponseValidationError{}
+
+// Validate checks the field values on StorageUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageUsageMultiError, or
+// nil if none found.
+func (m *StorageUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Bytes
+
+	// no validation rules for Files
+
+	// no validation rules for MaxBytes
+
+	// no validation rules for MaxFiles
+
+	if len(errors) > 0 {
+		return StorageUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUsageMultiError is an error wrapping multiple validation errors
+// returned by StorageUsage.ValidateAll() if the designated constraints aren't met.
+type StorageUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUsageMultiError) AllErrors() []error { return m }
+
+// StorageUsageValidationError is the validation error returned by
+// StorageUsage.Validate if the designated constraints aren't met.
+type StorageUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUsageValidationError) ErrorName() string { return "StorageUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUsageValidationError{}
+
+// Validate checks the field values on CreateFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFolderRequestMultiError, or nil if none found.
+func (m *CreateFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := CreateFolderRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateFolderRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateFolderRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFolderRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFolderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFolderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFolderRequestMultiError) AllErrors() []error { return m }
+
+// CreateFolderRequestValidationError is the validation error returned by
+// CreateFolderRequest.Validate if the designated constraints aren't met.
+type CreateFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFolderRequestValidationError) ErrorName() string {
+	return "CreateFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFolderRequestValidationError{}
+
+var _CreateFolderRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on CreateFolderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFolderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFolderResponseMultiError, or nil if none found.
+func (m *CreateFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolderHeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFolderResponseValidationError{
+					field:  "FolderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFolderResponseValidationError{
+					field:  "FolderHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolderHeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFolderResponseValidationError{
+				field:  "FolderHeader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFolderResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFolderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFolderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFolderResponseMultiError) AllErrors() []error { return m }
+
+// CreateFolderResponseValidationError is the validation error returned by
+// CreateFolderResponse.Validate if the designated constraints aren't met.
+type CreateFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFolderResponseValidationError) ErrorName() string {
+	return "CreateFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFolderResponseValidationError{}
+
+// Validate checks the field values on DeleteFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFolderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFolderRequestMultiError, or nil if none found.
+func (m *DeleteFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 1024 {
+		err := DeleteFolderRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteFolderRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := DeleteFolderRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Recursive
+
+	if len(errors) > 0 {
+		return DeleteFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFolderRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFolderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFolderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFolderRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFolderRequestValidationError is the validation error returned by
+// DeleteFolderRequest.Validate if the designated constraints aren't met.
+type DeleteFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFolderRequestValidationError) ErrorName() string {
+	return "DeleteFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFolderRequestValidationError{}
+
+var _DeleteFolderRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+// Validate checks the field values on FileHeader with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileHeaderMultiError, or
+// nil if none found.
+func (m *FileHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileHeaderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileHeaderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileHeaderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileHeaderValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileHeaderValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileHeaderValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sha256
+
+	// no validation rules for IsFolder
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return FileHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileHeaderMultiError is an error wrapping multiple validation errors
+// returned by FileHeader.ValidateAll() if the designated constraints aren't met.
+type FileHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileHeaderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileHeaderMultiError) AllErrors() []error { return m }
+
+// FileHeaderValidationError is the validation error returned by
+// FileHeader.Validate if the designated constraints aren't met.
+type FileHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileHeaderValidationError) ErrorName() string { return "FileHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileHeaderValidationError{}
+
+// Validate checks the field values on UploadFileRequest_Info with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileRequest_Info) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileRequest_Info with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileRequest_InfoMultiError, or nil if none found.
+func (m *UploadFileRequest_Info) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileRequest_Info) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetName() != "" {
+
+		if utf8.RuneCountInString(m.GetName()) > 1024 {
+			err := UploadFileRequest_InfoValidationError{
+				field:  "Name",
+				reason: "value length must be at most 1024 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UploadFileRequest_Info_Name_Pattern.MatchString(m.GetName()) {
+			err := UploadFileRequest_InfoValidationError{
+				field:  "Name",
+				reason: "value does not match regex pattern \"^[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*(/[^/\\\\\\\\.\\\\x00-\\\\x1f][^/\\\\\\\\\\\\x00-\\\\x1f]*)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ContentType
+
+	// no validation rules for UploadId
+
+	// no validation rules for Size
+
+	if m.GetSha256() != "" {
+
+		if utf8.RuneCountInString(m.GetSha256()) != 64 {
+			err := UploadFileRequest_InfoValidationError{
+				field:  "Sha256",
+				reason: "value length must be 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_UploadFileRequest_Info_Sha256_Pattern.MatchString(m.GetSha256()) {
+			err := UploadFileRequest_InfoValidationError{
+				field:  "Sha256",
+				reason: "value does not match regex pattern \"^[0-9a-fA-F]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrc32C()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadFileRequest_InfoValidationError{
+					field:  "Crc32C",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadFileRequest_InfoValidationError{
+					field:  "Crc32C",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrc32C()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadFileRequest_InfoValidationError{
+				field:  "Crc32C",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadFileRequest_InfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileRequest_InfoMultiError is an error wrapping multiple validation
+// errors returned by UploadFileRequest_Info.ValidateAll() if the designated
+// constraints aren't met.
+type UploadFileRequest_InfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileRequest_InfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileRequest_InfoMultiError) AllErrors() []error { return m }
+
+// UploadFileRequest_InfoValidationError is the validation error returned by
+// UploadFileRequest_Info.Validate if the designated constraints aren't met.
+type UploadFileRequest_InfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileRequest_InfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileRequest_InfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileRequest_InfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileRequest_InfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileRequest_InfoValidationError) ErrorName() string {
+	return "UploadFileRequest_InfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileRequest_InfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileRequest_Info.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileRequest_InfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileRequest_InfoValidationError{}
+
+var _UploadFileRequest_Info_Name_Pattern = regexp.MustCompile("^[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*(/[^/\\\\.\\x00-\\x1f][^/\\\\\\x00-\\x1f]*)*$")
+
+var _UploadFileRequest_Info_Sha256_Pattern = regexp.MustCompile("^[0-9a-fA-F]+$")
+
+// Validate checks the field values on UploadFileRequest_Chunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileRequest_Chunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileRequest_Chunk with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileRequest_ChunkMultiError, or nil if none found.
+func (m *UploadFileRequest_Chunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileRequest_Chunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UploadFileRequest_ChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileRequest_ChunkMultiError is an error wrapping multiple validation
+// errors returned by UploadFileRequest_Chunk.ValidateAll() if the designated
+// constraints aren't met.
+type UploadFileRequest_ChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileRequest_ChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileRequest_ChunkMultiError) AllErrors() []error { return m }
+
+// UploadFileRequest_ChunkValidationError is the validation error returned by
+// UploadFileRequest_Chunk.Validate if the designated constraints aren't met.
+type UploadFileRequest_ChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileRequest_ChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileRequest_ChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileRequest_ChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileRequest_ChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileRequest_ChunkValidationError) ErrorName() string {
+	return "UploadFileRequest_ChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileRequest_ChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileRequest_Chunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileRequest_ChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileRequest_ChunkValidationError{}
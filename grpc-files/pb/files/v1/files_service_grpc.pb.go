@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -23,8 +24,26 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FilesServiceClient interface {
 	ListFilesHeader(ctx context.Context, in *ListFilesHeaderRequest, opts ...grpc.CallOption) (*ListFilesHeaderResponse, error)
+	// First message of stream is file info, it starts new upload or resumes upload by upload id.
+	// Id of upload is sent in "upload-id" header before file content is received.
 	UploadFile(ctx context.Context, opts ...grpc.CallOption) (FilesService_UploadFileClient, error)
+	GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*GetUploadStatusResponse, error)
 	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (FilesService_DownloadFileClient, error)
+	// GetFileHeader returns header of file without reading of its content.
+	GetFileHeader(ctx context.Context, in *GetFileHeaderRequest, opts ...grpc.CallOption) (*GetFileHeaderResponse, error)
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*RenameFileResponse, error)
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*CopyFileResponse, error)
+	GetFileACL(ctx context.Context, in *GetFileACLRequest, opts ...grpc.CallOption) (*GetFileACLResponse, error)
+	// SetFileACL replaces readers and writers of file, it is allowed only for owner of file.
+	SetFileACL(ctx context.Context, in *SetFileACLRequest, opts ...grpc.CallOption) (*SetFileACLResponse, error)
+	// CreateSignedURL returns URL of gateway, which allows download or upload of file without credentials
+	// on behalf of caller until it is expired.
+	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	// GetUsage returns used storage of caller and of whole service with their limits.
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type filesServiceClient struct {
@@ -78,6 +97,15 @@ func (x *filesServiceUploadFileClient) CloseAndRecv() (*UploadFileResponse, erro
 	return m, nil
 }
 
+func (c *filesServiceClient) GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*GetUploadStatusResponse, error) {
+	out := new(GetUploadStatusResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/GetUploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *filesServiceClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (FilesService_DownloadFileClient, error) {
 	stream, err := c.cc.NewStream(ctx, &FilesService_ServiceDesc.Streams[1], "/example.files.v1.FilesService/DownloadFile", opts...)
 	if err != nil {
@@ -110,13 +138,121 @@ func (x *filesServiceDownloadFileClient) Recv() (*DownloadFileResponse, error) {
 	return m, nil
 }
 
+func (c *filesServiceClient) GetFileHeader(ctx context.Context, in *GetFileHeaderRequest, opts ...grpc.CallOption) (*GetFileHeaderResponse, error) {
+	out := new(GetFileHeaderResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/GetFileHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/DeleteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*RenameFileResponse, error) {
+	out := new(RenameFileResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/RenameFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*CopyFileResponse, error) {
+	out := new(CopyFileResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/CopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) GetFileACL(ctx context.Context, in *GetFileACLRequest, opts ...grpc.CallOption) (*GetFileACLResponse, error) {
+	out := new(GetFileACLResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/GetFileACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) SetFileACL(ctx context.Context, in *SetFileACLRequest, opts ...grpc.CallOption) (*SetFileACLResponse, error) {
+	out := new(SetFileACLResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/SetFileACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error) {
+	out := new(CreateSignedURLResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/CreateSignedURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/GetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/CreateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/example.files.v1.FilesService/DeleteFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FilesServiceServer is the server API for FilesService service.
 // All implementations must embed UnimplementedFilesServiceServer
 // for forward compatibility
 type FilesServiceServer interface {
 	ListFilesHeader(context.Context, *ListFilesHeaderRequest) (*ListFilesHeaderResponse, error)
+	// First message of stream is file info, it starts new upload or resumes upload by upload id.
+	// Id of upload is sent in "upload-id" header before file content is received.
 	UploadFile(FilesService_UploadFileServer) error
+	GetUploadStatus(context.Context, *GetUploadStatusRequest) (*GetUploadStatusResponse, error)
 	DownloadFile(*DownloadFileRequest, FilesService_DownloadFileServer) error
+	// GetFileHeader returns header of file without reading of its content.
+	GetFileHeader(context.Context, *GetFileHeaderRequest) (*GetFileHeaderResponse, error)
+	DeleteFile(context.Context, *DeleteFileRequest) (*emptypb.Empty, error)
+	RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error)
+	CopyFile(context.Context, *CopyFileRequest) (*CopyFileResponse, error)
+	GetFileACL(context.Context, *GetFileACLRequest) (*GetFileACLResponse, error)
+	// SetFileACL replaces readers and writers of file, it is allowed only for owner of file.
+	SetFileACL(context.Context, *SetFileACLRequest) (*SetFileACLResponse, error)
+	// CreateSignedURL returns URL of gateway, which allows download or upload of file without credentials
+	// on behalf of caller until it is expired.
+	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	// GetUsage returns used storage of caller and of whole service with their limits.
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedFilesServiceServer()
 }
 
@@ -130,9 +266,42 @@ func (UnimplementedFilesServiceServer) ListFilesHeader(context.Context, *ListFil
 func (UnimplementedFilesServiceServer) UploadFile(FilesService_UploadFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
 }
+func (UnimplementedFilesServiceServer) GetUploadStatus(context.Context, *GetUploadStatusRequest) (*GetUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadStatus not implemented")
+}
 func (UnimplementedFilesServiceServer) DownloadFile(*DownloadFileRequest, FilesService_DownloadFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
 }
+func (UnimplementedFilesServiceServer) GetFileHeader(context.Context, *GetFileHeaderRequest) (*GetFileHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileHeader not implemented")
+}
+func (UnimplementedFilesServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (UnimplementedFilesServiceServer) RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
+}
+func (UnimplementedFilesServiceServer) CopyFile(context.Context, *CopyFileRequest) (*CopyFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFile not implemented")
+}
+func (UnimplementedFilesServiceServer) GetFileACL(context.Context, *GetFileACLRequest) (*GetFileACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileACL not implemented")
+}
+func (UnimplementedFilesServiceServer) SetFileACL(context.Context, *SetFileACLRequest) (*SetFileACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileACL not implemented")
+}
+func (UnimplementedFilesServiceServer) CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSignedURL not implemented")
+}
+func (UnimplementedFilesServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedFilesServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedFilesServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
 func (UnimplementedFilesServiceServer) mustEmbedUnimplementedFilesServiceServer() {}
 
 // UnsafeFilesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -190,6 +359,24 @@ func (x *filesServiceUploadFileServer) Recv() (*UploadFileRequest, error) {
 	return m, nil
 }
 
+func _FilesService_GetUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).GetUploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/GetUploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).GetUploadStatus(ctx, req.(*GetUploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FilesService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DownloadFileRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -211,6 +398,186 @@ func (x *filesServiceDownloadFileServer) Send(m *DownloadFileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _FilesService_GetFileHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).GetFileHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/GetFileHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).GetFileHeader(ctx, req.(*GetFileHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/DeleteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_RenameFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).RenameFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/RenameFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).RenameFile(ctx, req.(*RenameFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).CopyFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/CopyFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).CopyFile(ctx, req.(*CopyFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_GetFileACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).GetFileACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/GetFileACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).GetFileACL(ctx, req.(*GetFileACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_SetFileACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFileACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).SetFileACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/SetFileACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).SetFileACL(ctx, req.(*SetFileACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_CreateSignedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSignedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).CreateSignedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/CreateSignedURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).CreateSignedURL(ctx, req.(*CreateSignedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/CreateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.files.v1.FilesService/DeleteFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FilesService_ServiceDesc is the grpc.ServiceDesc for FilesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +589,50 @@ var FilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFilesHeader",
 			Handler:    _FilesService_ListFilesHeader_Handler,
 		},
+		{
+			MethodName: "GetUploadStatus",
+			Handler:    _FilesService_GetUploadStatus_Handler,
+		},
+		{
+			MethodName: "GetFileHeader",
+			Handler:    _FilesService_GetFileHeader_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _FilesService_DeleteFile_Handler,
+		},
+		{
+			MethodName: "RenameFile",
+			Handler:    _FilesService_RenameFile_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _FilesService_CopyFile_Handler,
+		},
+		{
+			MethodName: "GetFileACL",
+			Handler:    _FilesService_GetFileACL_Handler,
+		},
+		{
+			MethodName: "SetFileACL",
+			Handler:    _FilesService_SetFileACL_Handler,
+		},
+		{
+			MethodName: "CreateSignedURL",
+			Handler:    _FilesService_CreateSignedURL_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _FilesService_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _FilesService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _FilesService_DeleteFolder_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{